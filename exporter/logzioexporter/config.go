@@ -5,12 +5,19 @@ package logzioexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
 // Config contains Logz.io specific configuration such as Account TracesToken, Region, etc.
@@ -18,21 +25,207 @@ type Config struct {
 	confighttp.ClientConfig   `mapstructure:",squash"`          // confighttp client settings https://pkg.go.dev/go.opentelemetry.io/collector/config/confighttp#ClientConfig
 	QueueSettings             exporterhelper.QueueBatchConfig   `mapstructure:"sending_queue"` // exporter helper queue settings https://pkg.go.dev/go.opentelemetry.io/collector/exporter/exporterhelper#QueueSettings
 	configretry.BackOffConfig `mapstructure:"retry_on_failure"` // exporter helper retry settings https://pkg.go.dev/go.opentelemetry.io/collector/exporter/exporterhelper#RetrySettings
-	Token                     configopaque.String               `mapstructure:"account_token"`    // Your Logz.io Account Token, can be found at https://app.logz.io/#/dashboard/settings/general
-	Region                    string                            `mapstructure:"region"`           // Your Logz.io 2-letter region code, can be found at https://docs.logz.io/user-guide/accounts/account-region.html#available-regions
-	CustomEndpoint            string                            `mapstructure:"custom_endpoint"`  // **Deprecation** Custom endpoint to ship traces to. Use only for dev and tests.
-	DrainInterval             int                               `mapstructure:"drain_interval"`   // **Deprecation** Queue drain interval in seconds. Defaults to `3`.
-	QueueCapacity             int64                             `mapstructure:"queue_capacity"`   // **Deprecation** Queue capacity in bytes. Defaults to `20 * 1024 * 1024` ~ 20mb.
-	QueueMaxLength            int                               `mapstructure:"queue_max_length"` // **Deprecation** Max number of items allowed in the queue. Defaults to `500000`.
+	Token                     configopaque.String               `mapstructure:"account_token"`           // Your Logz.io Account Token, can be found at https://app.logz.io/#/dashboard/settings/general
+	Region                    string                            `mapstructure:"region"`                  // Your Logz.io 2-letter region code, can be found at https://docs.logz.io/user-guide/accounts/account-region.html#available-regions
+	CustomEndpoint            string                            `mapstructure:"custom_endpoint"`         // **Deprecation** Custom endpoint to ship traces to. Use only for dev and tests.
+	DrainInterval             int                               `mapstructure:"drain_interval"`          // **Deprecation** Queue drain interval in seconds, mapped to the sending queue batch flush timeout.
+	QueueCapacity             int64                             `mapstructure:"queue_capacity"`          // **Deprecation** Queue capacity in bytes. Defaults to `20 * 1024 * 1024` ~ 20mb.
+	QueueMaxLength            int                               `mapstructure:"queue_max_length"`        // **Deprecation** Max number of items allowed in the queue. Defaults to `500000`.
+	TimestampField            string                            `mapstructure:"timestamp_field"`         // Name of the field holding the log record timestamp. Defaults to `@timestamp`.
+	TimestampFormat           string                            `mapstructure:"timestamp_format"`        // Format of the log record timestamp, one of `epoch-ms`, `epoch-ns` or `rfc3339`. Defaults to `epoch-ms`.
+	ServiceNameField          string                            `mapstructure:"service_name_field"`      // Name of the trace document field holding the service name. Defaults to `process.serviceName`.
+	OnQueueFull               string                            `mapstructure:"on_queue_full"`           // Policy applied to the batches rejected by a full sending queue, either `drop` or `block`. Defaults to `drop`.
+	SourceType                string                            `mapstructure:"source_type"`             // Logz.io type of the shipped logs, sent as the listener `type` parameter and document field. Defaults to the listener default.
+	DropEmptyRecords          bool                              `mapstructure:"drop_empty_records"`      // Drop log records with an empty body and no attributes instead of sending them. Defaults to `false`.
+	HeadersFromAttributes     map[string]string                 `mapstructure:"headers_from_attributes"` // Request headers set from resource attributes, as a map of header name to resource attribute key.
+	TypeFromAttribute         string                            `mapstructure:"type_from_attribute"`     // Attribute holding the Logz.io type of each log record, falling back to `source_type` when missing.
+	LogType                   string                            `mapstructure:"log_type"`                // Logz.io type written to the `type` field of each log record, taking precedence over `source_type`.
+	LogTypeAttribute          string                            `mapstructure:"log_type_attribute"`      // Resource attribute holding the Logz.io type of its log records, falling back to `log_type` when missing.
+	TokenFromAttribute        string                            `mapstructure:"token_from_attribute"`    // Resource attribute holding the Logz.io account token of each resource, falling back to `account_token` when missing.
+	MetricsToken              configopaque.String               `mapstructure:"metrics_token"`           // Your Logz.io Metrics Account Token, required to ship metrics. `account_token` may be omitted when only metrics are shipped.
+	MetricsEndpoint           string                            `mapstructure:"metrics_endpoint"`        // Custom endpoint to ship metrics to, overriding the region listener. Use only for dev and tests.
+	Sampling                  SamplingConfig                    `mapstructure:"sampling"`                // Sampling of the log records below a severity threshold. Disabled by default.
+	FieldMappings             map[string]string                 `mapstructure:"field_mappings"`          // Log record attributes renamed to Logz.io fields, as a map of attribute key to field name.
 }
 
+// SamplingConfig defines the sampling of low severity log records
+type SamplingConfig struct {
+	SeverityThreshold string  `mapstructure:"severity_threshold"` // Log records with a severity below this level are sampled, one of `debug`, `info`, `warn`, `error` or `fatal`. Sampling is disabled when empty.
+	KeepRatio         float64 `mapstructure:"keep_ratio"`         // Fraction of the sampled log records that is kept, between 0 and 1.
+}
+
+const (
+	defaultTimestampField   = "@timestamp"
+	defaultServiceNameField = "process.serviceName"
+
+	timestampFormatEpochMillis = "epoch-ms"
+	timestampFormatEpochNanos  = "epoch-ns"
+	timestampFormatRFC3339     = "rfc3339"
+
+	onQueueFullDrop  = "drop"
+	onQueueFullBlock = "block"
+
+	// defaultQueueMaxLength is the queue size in items used when `drain_interval` switches the queue to the items sizer
+	defaultQueueMaxLength = 500000
+	// drainBatchSize is the number of items flushing a batch before `drain_interval` elapses
+	drainBatchSize = 8192
+)
+
+// logTypePattern matches the characters allowed by Logz.io in the `type` field
+var logTypePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
 func (c *Config) Validate() error {
-	if c.Token == "" {
-		return errors.New("`account_token` not specified")
+	if c.Token == "" && c.MetricsToken == "" {
+		return errors.New("neither `account_token` nor `metrics_token` specified")
+	}
+	if c.DrainInterval < 0 {
+		return fmt.Errorf("`drain_interval` must not be negative, got %d", c.DrainInterval)
+	}
+	if c.Region != "" {
+		if _, ok := listenerHosts[strings.ToLower(c.Region)]; !ok {
+			return fmt.Errorf("`region` must be one of %s, got %q", strings.Join(validRegions(), ", "), c.Region)
+		}
+	}
+	switch c.TimestampFormat {
+	case "", timestampFormatEpochMillis, timestampFormatEpochNanos, timestampFormatRFC3339:
+	default:
+		return fmt.Errorf("`timestamp_format` must be one of %q, %q or %q, got %q", timestampFormatEpochMillis, timestampFormatEpochNanos, timestampFormatRFC3339, c.TimestampFormat)
+	}
+	switch c.OnQueueFull {
+	case "", onQueueFullDrop, onQueueFullBlock:
+	default:
+		return fmt.Errorf("`on_queue_full` must be either %q or %q, got %q", onQueueFullDrop, onQueueFullBlock, c.OnQueueFull)
+	}
+	if c.OnQueueFull != "" && c.QueueSettings.BlockOnOverflow {
+		return errors.New("`on_queue_full` can't be set with `sending_queue::block_on_overflow`, a blocking queue is never full")
+	}
+	if c.SourceType != "" && !logTypePattern.MatchString(c.SourceType) {
+		return fmt.Errorf("`source_type` may only contain letters, digits, underscores and hyphens, got %q", c.SourceType)
+	}
+	if c.LogType != "" && !logTypePattern.MatchString(c.LogType) {
+		return fmt.Errorf("`log_type` may only contain letters, digits, underscores and hyphens, got %q", c.LogType)
+	}
+	if err := c.validateFieldMappings(); err != nil {
+		return err
+	}
+	return c.Sampling.Validate()
+}
+
+// validateFieldMappings checks that each attribute is renamed to a non empty field that no other attribute is renamed to
+func (c *Config) validateFieldMappings() error {
+	sources := make(map[string]string, len(c.FieldMappings))
+	for _, source := range slices.Sorted(maps.Keys(c.FieldMappings)) {
+		target := c.FieldMappings[source]
+		if target == "" {
+			return fmt.Errorf("`field_mappings` maps %q to an empty field name", source)
+		}
+		if other, ok := sources[target]; ok {
+			return fmt.Errorf("`field_mappings` maps both %q and %q to %q", other, source, target)
+		}
+		sources[target] = source
+	}
+	return nil
+}
+
+func (c *SamplingConfig) Validate() error {
+	if c.SeverityThreshold == "" {
+		return nil
+	}
+	if _, ok := severityThresholds[strings.ToLower(c.SeverityThreshold)]; !ok {
+		return fmt.Errorf("`sampling::severity_threshold` must be one of debug, info, warn, error or fatal, got %q", c.SeverityThreshold)
+	}
+	if c.KeepRatio < 0 || c.KeepRatio > 1 {
+		return fmt.Errorf("`sampling::keep_ratio` must be between 0 and 1, got %v", c.KeepRatio)
+	}
+	return nil
+}
+
+// validRegions returns the known Logz.io regions in alphabetical order
+func validRegions() []string {
+	regions := make([]string, 0, len(listenerHosts))
+	for region := range listenerHosts {
+		regions = append(regions, region)
 	}
+	slices.Sort(regions)
+	return regions
+}
+
+// sanitize derives the listener endpoint from the region when no endpoint is set, either directly or
+// through the deprecated `custom_endpoint` option, which must have been mapped before
+func (c *Config) sanitize() error {
+	endpoint, err := generateEndpoint(c)
+	if err != nil {
+		return err
+	}
+	c.Endpoint = endpoint
 	return nil
 }
 
+// metricsEndpoint returns the Prometheus remote write endpoint metrics are shipped to
+func (c *Config) metricsEndpoint() string {
+	if c.MetricsEndpoint != "" {
+		return c.MetricsEndpoint
+	}
+	return getMetricsListenerURL(c.Region)
+}
+
+// timestampField returns the name of the field holding the log record timestamp
+func (c *Config) timestampField() string {
+	if c.TimestampField == "" {
+		return defaultTimestampField
+	}
+	return c.TimestampField
+}
+
+// serviceNameField returns the name of the trace document field holding the service name
+func (c *Config) serviceNameField() string {
+	if c.ServiceNameField == "" {
+		return defaultServiceNameField
+	}
+	return c.ServiceNameField
+}
+
+// logType returns the Logz.io type of a log record, read from the `type_from_attribute` merged attribute or
+// the `log_type_attribute` resource attribute when they hold a valid type, or the static `log_type` or
+// `source_type` otherwise
+func (c *Config) logType(resource pcommon.Map, attributes pcommon.Map) string {
+	if logType, ok := attributeLogType(attributes, c.TypeFromAttribute); ok {
+		return logType
+	}
+	if logType, ok := attributeLogType(resource, c.LogTypeAttribute); ok {
+		return logType
+	}
+	if c.LogType != "" {
+		return c.LogType
+	}
+	return c.SourceType
+}
+
+// attributeLogType returns the value of the attribute, if it only holds characters allowed in the `type` field
+func attributeLogType(attributes pcommon.Map, key string) (string, bool) {
+	if key == "" {
+		return "", false
+	}
+	value, ok := attributes.Get(key)
+	if !ok {
+		return "", false
+	}
+	logType := value.AsString()
+	return logType, logTypePattern.MatchString(logType)
+}
+
+// formatTimestamp serializes a log record timestamp according to the configured format
+func (c *Config) formatTimestamp(t time.Time) any {
+	switch c.TimestampFormat {
+	case timestampFormatEpochNanos:
+		return t.UnixNano()
+	case timestampFormatRFC3339:
+		return t.UTC().Format(time.RFC3339Nano)
+	default:
+		return t.UnixMilli()
+	}
+}
+
 // CheckAndWarnDeprecatedOptions Is checking for soon deprecated configuration options (queue_max_length, queue_capacity, drain_interval, custom_endpoint) log a warning message and map to the relevant updated option
 func (c *Config) checkAndWarnDeprecatedOptions(logger hclog.Logger) {
 	if c.QueueCapacity != 0 {
@@ -45,8 +238,14 @@ func (c *Config) checkAndWarnDeprecatedOptions(logger hclog.Logger) {
 		logger.Warn("Mapping `queue_max_length` -> `QueueSettings.QueueSize`")
 		c.QueueSettings.QueueSize = int64(c.QueueMaxLength)
 	}
+	// Warn about drain_interval, mapped to QueueSettings.Batch.FlushTimeout by queueSettings
 	if c.DrainInterval != 0 {
-		logger.Warn("You are using the deprecated `drain_interval` option that will be removed in the next release; use batch processor `timeout` configuration instead: https://github.com/open-telemetry/opentelemetry-collector/tree/main/processor/batchprocessor#batch-processor")
+		logger.Warn("You are using the deprecated `drain_interval` option that will be removed in the next release; use exporter helper `sending_queue::batch::flush_timeout` configuration instead: https://github.com/open-telemetry/opentelemetry-collector/blob/main/exporter/exporterhelper/README.md")
+		if c.QueueSettings.Batch != nil {
+			logger.Warn("Ignoring `drain_interval` as `sending_queue::batch` is configured")
+		} else {
+			logger.Warn("Mapping `drain_interval` -> `QueueSettings.Batch.FlushTimeout`")
+		}
 	}
 	// Warn and map CustomEndpoint -> Endpoint
 	if c.CustomEndpoint != "" {
@@ -55,3 +254,27 @@ func (c *Config) checkAndWarnDeprecatedOptions(logger hclog.Logger) {
 		c.Endpoint = c.CustomEndpoint
 	}
 }
+
+// queueSettings returns the sending queue settings of the exporters. Unless `sending_queue::batch` is configured,
+// `drain_interval` enables batching in a copy of the settings, so that queued items are flushed every `drain_interval`
+// seconds or as soon as a batch is full. Batches are sized in items, so a queue sized in requests is switched to
+// items, with the legacy `queue_max_length` default size when the queue size was left to its default.
+// The config itself is shared by the exporters of all signals and is left untouched.
+func (c *Config) queueSettings() exporterhelper.QueueBatchConfig {
+	queueSettings := c.QueueSettings
+	if c.DrainInterval == 0 || queueSettings.Batch != nil {
+		return queueSettings
+	}
+	queueSettings.Enabled = true
+	queueSettings.Batch = &exporterhelper.BatchConfig{
+		FlushTimeout: time.Duration(c.DrainInterval) * time.Second,
+		MinSize:      drainBatchSize,
+	}
+	if queueSettings.Sizer != exporterhelper.RequestSizerTypeItems && queueSettings.Sizer != exporterhelper.RequestSizerTypeBytes {
+		queueSettings.Sizer = exporterhelper.RequestSizerTypeItems
+		if c.QueueMaxLength == 0 && (queueSettings.QueueSize == 0 || queueSettings.QueueSize == exporterhelper.NewDefaultQueueConfig().QueueSize) {
+			queueSettings.QueueSize = defaultQueueMaxLength
+		}
+	}
+	return queueSettings
+}
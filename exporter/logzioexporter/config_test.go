@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -34,6 +35,10 @@ func TestLoadConfig(t *testing.T) {
 	expected := &Config{
 		Token:  "token",
 		Region: "eu",
+		Sampling: SamplingConfig{
+			SeverityThreshold: "warn",
+			KeepRatio:         0.1,
+		},
 	}
 	expected.BackOffConfig = configretry.NewDefaultBackOffConfig()
 	expected.MaxInterval = 5 * time.Second
@@ -109,6 +114,54 @@ func TestCheckAndWarnDeprecatedOptions(t *testing.T) {
 	}
 	expected.QueueSettings.QueueSize = 10
 	assert.Equal(t, expected, actualCfg)
+
+	queueSettings := actualCfg.queueSettings()
+	assert.Equal(t, int64(10), queueSettings.QueueSize)
+	assert.Equal(t, exporterhelper.RequestSizerTypeItems, queueSettings.Sizer)
+	assert.Equal(t, &exporterhelper.BatchConfig{FlushTimeout: 10 * time.Second, MinSize: drainBatchSize}, queueSettings.Batch)
+}
+
+func TestDrainIntervalMapping(t *testing.T) {
+	params := exportertest.NewNopSettings(metadata.Type)
+	logger := hclog2ZapLogger{
+		Zap:  params.Logger,
+		name: loggerName,
+	}
+
+	cfg := &Config{
+		Token:         "token",
+		QueueSettings: exporterhelper.NewDefaultQueueConfig(),
+		DrainInterval: 3,
+	}
+	cfg.checkAndWarnDeprecatedOptions(&logger)
+	queueSettings := cfg.queueSettings()
+	assert.True(t, queueSettings.Enabled)
+	assert.Equal(t, exporterhelper.RequestSizerTypeItems, queueSettings.Sizer)
+	assert.Equal(t, int64(defaultQueueMaxLength), queueSettings.QueueSize)
+	assert.Equal(t, &exporterhelper.BatchConfig{FlushTimeout: 3 * time.Second, MinSize: drainBatchSize}, queueSettings.Batch)
+	// The shared config is left untouched, so that the exporters of the other signals map it the same way.
+	assert.Equal(t, exporterhelper.NewDefaultQueueConfig(), cfg.QueueSettings)
+
+	// A configured queue size is kept.
+	cfg.QueueSettings.QueueSize = 200
+	assert.Equal(t, int64(200), cfg.queueSettings().QueueSize)
+
+	batch := &exporterhelper.BatchConfig{FlushTimeout: time.Second, MinSize: 100}
+	cfg = &Config{
+		Token:         "token",
+		QueueSettings: exporterhelper.NewDefaultQueueConfig(),
+		DrainInterval: 3,
+	}
+	cfg.QueueSettings.Batch = batch
+	cfg.checkAndWarnDeprecatedOptions(&logger)
+	assert.Same(t, batch, cfg.queueSettings().Batch, "configured batch should be kept")
+	assert.Equal(t, exporterhelper.NewDefaultQueueConfig().Sizer, cfg.queueSettings().Sizer)
+
+	cfg = &Config{
+		Token:         "token",
+		DrainInterval: -1,
+	}
+	assert.EqualError(t, cfg.Validate(), "`drain_interval` must not be negative, got -1")
 }
 
 func TestNullTokenConfig(tester *testing.T) {
@@ -117,3 +170,110 @@ func TestNullTokenConfig(tester *testing.T) {
 	}
 	assert.Error(tester, cfg.Validate(), "Empty token should produce error")
 }
+
+func TestMetricsTokenOnlyConfig(t *testing.T) {
+	cfg := Config{
+		MetricsToken: "metrics-token",
+		Region:       "eu",
+	}
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestInvalidTimestampFormatConfig(t *testing.T) {
+	cfg := Config{
+		Token:           "token",
+		TimestampFormat: "epoch-s",
+	}
+	assert.EqualError(t, cfg.Validate(), "`timestamp_format` must be one of \"epoch-ms\", \"epoch-ns\" or \"rfc3339\", got \"epoch-s\"")
+}
+
+func TestInvalidOnQueueFullConfig(t *testing.T) {
+	cfg := Config{
+		Token:       "token",
+		OnQueueFull: "wait",
+	}
+	assert.EqualError(t, cfg.Validate(), "`on_queue_full` must be either \"drop\" or \"block\", got \"wait\"")
+}
+
+func TestInvalidSamplingConfig(t *testing.T) {
+	cfg := Config{
+		Token: "token",
+		Sampling: SamplingConfig{
+			SeverityThreshold: "verbose",
+		},
+	}
+	assert.EqualError(t, cfg.Validate(), "`sampling::severity_threshold` must be one of debug, info, warn, error or fatal, got \"verbose\"")
+	cfg.Sampling = SamplingConfig{
+		SeverityThreshold: "WARN",
+		KeepRatio:         1.5,
+	}
+	assert.EqualError(t, cfg.Validate(), "`sampling::keep_ratio` must be between 0 and 1, got 1.5")
+	cfg.Sampling.KeepRatio = 0.1
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestInvalidSourceTypeConfig(t *testing.T) {
+	cfg := Config{
+		Token:      "token",
+		SourceType: "nginx access",
+	}
+	assert.EqualError(t, cfg.Validate(), "`source_type` may only contain letters, digits, underscores and hyphens, got \"nginx access\"")
+	cfg.SourceType = "nginx-access_v2"
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestInvalidLogTypeConfig(t *testing.T) {
+	cfg := Config{
+		Token:   "token",
+		LogType: "java/app",
+	}
+	assert.EqualError(t, cfg.Validate(), "`log_type` may only contain letters, digits, underscores and hyphens, got \"java/app\"")
+	cfg.LogType = "java_app-v2"
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestInvalidRegionConfig(t *testing.T) {
+	cfg := Config{
+		Token:  "token",
+		Region: "us-east",
+	}
+	assert.EqualError(t, cfg.Validate(), "`region` must be one of au, ca, eu, nl, uk, us, wa, got \"us-east\"")
+}
+
+func TestSanitizeRegionEndpoint(t *testing.T) {
+	tests := []struct {
+		region   string
+		expected string
+	}{
+		{"us", "https://listener.logz.io:8071/?token=token"},
+		{"eu", "https://listener-eu.logz.io:8071/?token=token"},
+		{"au", "https://listener-au.logz.io:8071/?token=token"},
+		{"ca", "https://listener-ca.logz.io:8071/?token=token"},
+		{"nl", "https://listener-nl.logz.io:8071/?token=token"},
+		{"uk", "https://listener-uk.logz.io:8071/?token=token"},
+		{"wa", "https://listener-wa.logz.io:8071/?token=token"},
+		{"EU", "https://listener-eu.logz.io:8071/?token=token"},
+	}
+	for _, test := range tests {
+		t.Run(test.region, func(t *testing.T) {
+			cfg := Config{
+				Token:  "token",
+				Region: test.region,
+			}
+			require.NoError(t, cfg.Validate())
+			require.NoError(t, cfg.sanitize())
+			assert.Equal(t, test.expected, cfg.Endpoint)
+		})
+	}
+}
+
+func TestSanitizeKeepsEndpoint(t *testing.T) {
+	cfg := Config{
+		Token:          "token",
+		Region:         "eu",
+		CustomEndpoint: "https://api.example.com",
+	}
+	cfg.checkAndWarnDeprecatedOptions(hclog.NewNullLogger())
+	require.NoError(t, cfg.sanitize())
+	assert.Equal(t, "https://api.example.com", cfg.Endpoint)
+}
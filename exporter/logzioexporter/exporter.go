@@ -13,8 +13,10 @@ import (
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/golang/snappy"
 	"github.com/hashicorp/go-hclog"
 	"github.com/jaegertracing/jaeger-idl/model/v1"
 	"go.opentelemetry.io/collector/component"
@@ -23,11 +25,16 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/multierr"
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter/internal/cache"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
 )
 
@@ -39,11 +46,16 @@ const (
 
 // logzioExporter implements an OpenTelemetry trace exporter that exports all spans to Logz.io
 type logzioExporter struct {
-	config       *Config
-	client       *http.Client
-	logger       hclog.Logger
-	settings     component.TelemetrySettings
-	serviceCache cache.Cache
+	config           *Config
+	client           *http.Client
+	logger           hclog.Logger
+	settings         component.TelemetrySettings
+	serviceCache     cache.Cache
+	telemetryBuilder *metadata.TelemetryBuilder
+	sampler          *logSampler
+	// logsEndpoint is the endpoint logs are shipped to, with the source type of the logs. It is kept
+	// apart from the config, which is shared with the exporters of the other signals.
+	logsEndpoint string
 }
 
 func newLogzioExporter(cfg *Config, params exporter.Settings) (*logzioExporter, error) {
@@ -54,10 +66,17 @@ func newLogzioExporter(cfg *Config, params exporter.Settings) (*logzioExporter,
 	if cfg == nil {
 		return nil, errors.New("exporter config can't be null")
 	}
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(params.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
 	return &logzioExporter{
-		config:   cfg,
-		logger:   &logger,
-		settings: params.TelemetrySettings,
+		config:           cfg,
+		logger:           &logger,
+		settings:         params.TelemetrySettings,
+		telemetryBuilder: telemetryBuilder,
+		sampler:          newLogSampler(cfg.Sampling),
+		logsEndpoint:     cfg.Endpoint,
 		serviceCache: cache.NewLRUWithOptions(
 			100000,
 			&cache.Options{
@@ -72,22 +91,32 @@ func newLogzioTracesExporter(config *Config, set exporter.Settings) (exporter.Tr
 	if err != nil {
 		return nil, err
 	}
-	exporter.config.Endpoint, err = generateEndpoint(config)
-	if err != nil {
-		return nil, err
+	if config.Token == "" {
+		return nil, errors.New("`account_token` is required to export traces")
 	}
 	config.checkAndWarnDeprecatedOptions(exporter.logger)
-	return exporterhelper.NewTraces(
+	if err = config.sanitize(); err != nil {
+		return nil, err
+	}
+	tracesExporter, err := exporterhelper.NewTraces(
 		context.TODO(),
 		set,
 		config,
 		exporter.pushTraceData,
 		exporterhelper.WithStart(exporter.start),
+		exporterhelper.WithShutdown(exporter.shutdown),
 		// disable since we rely on http.Client timeout logic.
 		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
-		exporterhelper.WithQueue(config.QueueSettings),
+		exporterhelper.WithQueue(config.queueSettings()),
 		exporterhelper.WithRetry(config.BackOffConfig),
 	)
+	if err != nil {
+		return nil, err
+	}
+	return &queueFullTraces{
+		Traces:  tracesExporter,
+		handler: newQueueFullHandler(config.OnQueueFull, tracesExporter.ConsumeTraces, exporter.telemetryBuilder),
+	}, nil
 }
 
 func newLogzioLogsExporter(config *Config, set exporter.Settings) (exporter.Logs, error) {
@@ -95,22 +124,66 @@ func newLogzioLogsExporter(config *Config, set exporter.Settings) (exporter.Logs
 	if err != nil {
 		return nil, err
 	}
-	exporter.config.Endpoint, err = generateEndpoint(config)
+	if config.Token == "" {
+		return nil, errors.New("`account_token` is required to export logs")
+	}
+	config.checkAndWarnDeprecatedOptions(exporter.logger)
+	if err = config.sanitize(); err != nil {
+		return nil, err
+	}
+	exporter.logsEndpoint, err = withSourceType(config.Endpoint, config.SourceType)
 	if err != nil {
 		return nil, err
 	}
-	config.checkAndWarnDeprecatedOptions(exporter.logger)
-	return exporterhelper.NewLogs(
+	logsExporter, err := exporterhelper.NewLogs(
 		context.TODO(),
 		set,
 		config,
 		exporter.pushLogData,
 		exporterhelper.WithStart(exporter.start),
+		exporterhelper.WithShutdown(exporter.shutdown),
 		// disable since we rely on http.Client timeout logic.
 		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
-		exporterhelper.WithQueue(config.QueueSettings),
+		exporterhelper.WithQueue(config.queueSettings()),
 		exporterhelper.WithRetry(config.BackOffConfig),
 	)
+	if err != nil {
+		return nil, err
+	}
+	return &queueFullLogs{
+		Logs:    logsExporter,
+		handler: newQueueFullHandler(config.OnQueueFull, logsExporter.ConsumeLogs, exporter.telemetryBuilder),
+	}, nil
+}
+
+func newLogzioMetricsExporter(config *Config, set exporter.Settings) (exporter.Metrics, error) {
+	exporter, err := newLogzioExporter(config, set)
+	if err != nil {
+		return nil, err
+	}
+	if config.MetricsToken == "" {
+		return nil, errors.New("`metrics_token` is required to export metrics")
+	}
+	config.checkAndWarnDeprecatedOptions(exporter.logger)
+	metricsExporter, err := exporterhelper.NewMetrics(
+		context.TODO(),
+		set,
+		config,
+		exporter.pushMetricsData,
+		exporterhelper.WithStart(exporter.start),
+		exporterhelper.WithShutdown(exporter.shutdown),
+		// disable since we rely on http.Client timeout logic.
+		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
+		exporterhelper.WithQueue(config.queueSettings()),
+		exporterhelper.WithRetry(config.BackOffConfig),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &queueFullMetrics{
+		Metrics: metricsExporter,
+		handler: newQueueFullHandler(config.OnQueueFull, metricsExporter.ConsumeMetrics, exporter.telemetryBuilder),
+	}, nil
 }
 
 func (exporter *logzioExporter) start(ctx context.Context, host component.Host) error {
@@ -122,8 +195,46 @@ func (exporter *logzioExporter) start(ctx context.Context, host component.Host)
 	return nil
 }
 
+func (exporter *logzioExporter) shutdown(context.Context) error {
+	exporter.telemetryBuilder.Shutdown()
+	return nil
+}
+
 func (exporter *logzioExporter) pushLogData(ctx context.Context, ld plog.Logs) error {
+	if len(exporter.config.HeadersFromAttributes) == 0 && exporter.config.TokenFromAttribute == "" {
+		return exporter.pushLogs(ctx, ld, exporter.logsEndpoint, nil)
+	}
+	// Resources with different tokens or header values can't share a request, so each group is sent separately
+	// and only the groups that failed with a retryable error are retried.
+	var errs, permanentErrs error
+	failed := plog.NewLogs()
+	for _, group := range groupLogsByRequest(ld, exporter.config.HeadersFromAttributes, exporter.config.TokenFromAttribute) {
+		endpoint, err := withToken(exporter.logsEndpoint, group.token)
+		if err != nil {
+			err = consumererror.NewPermanent(err)
+		} else {
+			err = exporter.pushLogs(ctx, group.logs, endpoint, group.header)
+		}
+		switch {
+		case consumererror.IsPermanent(err):
+			permanentErrs = errors.Join(permanentErrs, err)
+		case err != nil:
+			errs = errors.Join(errs, err)
+			group.logs.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+		}
+	}
+	if errs == nil {
+		return permanentErrs
+	}
+	if permanentErrs != nil {
+		exporter.logger.Error("Dropping the logs of the groups that failed permanently", "error", permanentErrs.Error())
+	}
+	return consumererror.NewLogs(errs, failed)
+}
+
+func (exporter *logzioExporter) pushLogs(ctx context.Context, ld plog.Logs, endpoint string, header http.Header) error {
 	var dataBuffer bytes.Buffer
+	var dropped, sampled int64
 	resourceLogs := ld.ResourceLogs()
 	for i := 0; i < resourceLogs.Len(); i++ {
 		resource := resourceLogs.At(i).Resource()
@@ -133,9 +244,17 @@ func (exporter *logzioExporter) pushLogData(ctx context.Context, ld plog.Logs) e
 			scope := scopeLogs.At(j).Scope()
 			for k := 0; k < logRecords.Len(); k++ {
 				log := logRecords.At(k)
+				if exporter.config.DropEmptyRecords && isEmptyLogRecord(log) {
+					dropped++
+					continue
+				}
+				if !exporter.sampler.keep(log) {
+					sampled++
+					continue
+				}
 				details := mergeMapEntries(resource.Attributes(), scope.Attributes(), log.Attributes())
 				details.PutStr(`scopeName`, scope.Name())
-				jsonLog, err := json.Marshal(convertLogRecordToJSON(log, details))
+				jsonLog, err := json.Marshal(convertLogRecordToJSON(log, resource.Attributes(), details, exporter.config))
 				if err != nil {
 					return err
 				}
@@ -146,12 +265,45 @@ func (exporter *logzioExporter) pushLogData(ctx context.Context, ld plog.Logs) e
 			}
 		}
 	}
-	err := exporter.export(ctx, exporter.config.Endpoint, dataBuffer.Bytes())
+	if dropped > 0 {
+		exporter.telemetryBuilder.LogzioexporterRecordsDropped.Add(ctx, dropped)
+		exporter.logger.Debug(fmt.Sprintf("Dropped %d empty log records", dropped))
+	}
+	if sampled > 0 {
+		exporter.telemetryBuilder.LogzioexporterRecordsSampled.Add(ctx, sampled)
+		exporter.logger.Debug(fmt.Sprintf("Dropped %d log records below the sampling severity threshold", sampled))
+	}
+	if (dropped > 0 || sampled > 0) && dataBuffer.Len() == 0 {
+		return nil
+	}
+	err := exporter.export(ctx, endpoint, dataBuffer.Bytes(), countDocuments(dataBuffer.Bytes()), header)
 	// reset the data buffer after each export to prevent duplicated data
 	dataBuffer.Reset()
 	return err
 }
 
+// isEmptyLogRecord reports whether a log record has neither a body nor attributes
+func isEmptyLogRecord(log plog.LogRecord) bool {
+	if log.Attributes().Len() > 0 {
+		return false
+	}
+	body := log.Body()
+	switch body.Type() {
+	case pcommon.ValueTypeEmpty:
+		return true
+	case pcommon.ValueTypeStr:
+		return body.Str() == ""
+	case pcommon.ValueTypeMap:
+		return body.Map().Len() == 0
+	case pcommon.ValueTypeSlice:
+		return body.Slice().Len() == 0
+	case pcommon.ValueTypeBytes:
+		return body.Bytes().Len() == 0
+	default:
+		return false
+	}
+}
+
 func mergeMapEntries(maps ...pcommon.Map) pcommon.Map {
 	res := map[string]any{}
 	for _, m := range maps {
@@ -181,6 +333,38 @@ func mergeMapEntries(maps ...pcommon.Map) pcommon.Map {
 }
 
 func (exporter *logzioExporter) pushTraceData(ctx context.Context, traces ptrace.Traces) error {
+	if len(exporter.config.HeadersFromAttributes) == 0 && exporter.config.TokenFromAttribute == "" {
+		return exporter.pushTraces(ctx, traces, exporter.config.Endpoint, nil)
+	}
+	// Resources with different tokens or header values can't share a request, so each group is sent separately
+	// and only the groups that failed with a retryable error are retried.
+	var errs, permanentErrs error
+	failed := ptrace.NewTraces()
+	for _, group := range groupTracesByRequest(traces, exporter.config.HeadersFromAttributes, exporter.config.TokenFromAttribute) {
+		endpoint, err := withToken(exporter.config.Endpoint, group.token)
+		if err != nil {
+			err = consumererror.NewPermanent(err)
+		} else {
+			err = exporter.pushTraces(ctx, group.traces, endpoint, group.header)
+		}
+		switch {
+		case consumererror.IsPermanent(err):
+			permanentErrs = errors.Join(permanentErrs, err)
+		case err != nil:
+			errs = errors.Join(errs, err)
+			group.traces.ResourceSpans().MoveAndAppendTo(failed.ResourceSpans())
+		}
+	}
+	if errs == nil {
+		return permanentErrs
+	}
+	if permanentErrs != nil {
+		exporter.logger.Error("Dropping the traces of the groups that failed permanently", "error", permanentErrs.Error())
+	}
+	return consumererror.NewTraces(errs, failed)
+}
+
+func (exporter *logzioExporter) pushTraces(ctx context.Context, traces ptrace.Traces, endpoint string, header http.Header) error {
 	// a buffer to store logzio span and services bytes
 	var dataBuffer bytes.Buffer
 	batches := jaeger.ProtoFromTraces(traces)
@@ -189,7 +373,7 @@ func (exporter *logzioExporter) pushTraceData(ctx context.Context, traces ptrace
 			span.Process = batch.Process
 			span.Tags = exporter.dropEmptyTags(span.Tags)
 			span.Process.Tags = exporter.dropEmptyTags(span.Process.Tags)
-			logzioSpan, transformErr := transformToLogzioSpanBytes(span)
+			logzioSpan, transformErr := transformToLogzioSpanBytes(span, exporter.config.serviceNameField())
 			if transformErr != nil {
 				return transformErr
 			}
@@ -218,25 +402,66 @@ func (exporter *logzioExporter) pushTraceData(ctx context.Context, traces ptrace
 			}
 		}
 	}
-	err := exporter.export(ctx, exporter.config.Endpoint, dataBuffer.Bytes())
+	err := exporter.export(ctx, endpoint, dataBuffer.Bytes(), countDocuments(dataBuffer.Bytes()), header)
 	// reset the data buffer after each export to prevent duplicated data
 	dataBuffer.Reset()
 	return err
 }
 
+// pushMetricsData ships the metrics to the Logz.io Prometheus remote write listener, authenticated with the metrics token
+func (exporter *logzioExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics) error {
+	writeRequest, err := toWriteRequest(md)
+	if len(writeRequest.Timeseries) == 0 {
+		if err != nil {
+			return consumererror.NewPermanent(fmt.Errorf("failed to translate metrics: %w", err))
+		}
+		return nil
+	}
+	if err != nil {
+		exporter.logger.Warn("Failed to translate metrics, exporting remaining metrics",
+			"dropped", len(multierr.Errors(err)),
+			"error", err.Error())
+	}
+	body, err := writeRequest.Marshal()
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "application/x-protobuf")
+	// Remote write requires the snappy block format, setting the encoding skips the client compression.
+	header.Set("Content-Encoding", "snappy")
+	header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	header.Set("Authorization", "Bearer "+string(exporter.config.MetricsToken))
+	request := snappy.Encode(nil, body)
+	return exporter.export(ctx, exporter.config.metricsEndpoint(), request, int64(len(writeRequest.Timeseries)), header)
+}
+
+// countDocuments returns the number of newline delimited documents of a request
+func countDocuments(request []byte) int64 {
+	return int64(bytes.Count(request, []byte{'\n'}))
+}
+
 // export is similar to otlphttp export method with changes in log messages + Permanent error for `StatusUnauthorized` and `StatusForbidden`
 // https://github.com/open-telemetry/opentelemetry-collector/blob/main/exporter/otlphttpexporter/otlp.go#L127
-func (exporter *logzioExporter) export(ctx context.Context, url string, request []byte) error {
+func (exporter *logzioExporter) export(ctx context.Context, url string, request []byte, documents int64, header http.Header) error {
 	exporter.logger.Debug(fmt.Sprintf("Preparing to make HTTP request with %d bytes", len(request)))
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(request))
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
-	req.Header.Set("Content-Type", "application/json")
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	start := time.Now()
 	resp, err := exporter.client.Do(req)
 	if err != nil {
+		exporter.recordExport(ctx, req.URL.Host, documents, time.Since(start), false)
 		return fmt.Errorf("failed to make an HTTP request: %w", err)
 	}
+	exporter.recordExport(ctx, req.URL.Host, documents, time.Since(start), resp.StatusCode >= 200 && resp.StatusCode <= 299)
 
 	defer func() {
 		// Discard any remaining response body when we are done reading.
@@ -248,10 +473,15 @@ func (exporter *logzioExporter) export(ctx context.Context, url string, request
 		// Request is successful.
 		return nil
 	}
-	respStatus := readResponse(resp)
-	// Format the error message. Use the status if it is present in the response.
+	body := readResponseBody(resp)
+	// Format the error message. Use the listener response or the status if present in the response.
 	var formattedErr error
-	if respStatus != nil {
+	if listenerResp := parseListenerResponse(resp.StatusCode, body); listenerResp != nil {
+		exporter.logRejectedLines(listenerResp)
+		formattedErr = fmt.Errorf(
+			"error exporting items, request to %s responded with HTTP Status Code %d, MalformedLines=%d",
+			url, resp.StatusCode, *listenerResp.MalformedLines)
+	} else if respStatus := decodeStatus(body); respStatus != nil {
 		formattedErr = fmt.Errorf(
 			"error exporting items, request to %s responded with HTTP Status Code %d, Message=%s, Details=%v",
 			url, resp.StatusCode, respStatus.Message, respStatus.Details)
@@ -266,14 +496,9 @@ func (exporter *logzioExporter) export(ctx context.Context, url string, request
 	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
 		// Fallback to 0 if the Retry-After header is not present. This will trigger the
 		// default backoff policy by our caller (retry handler).
-		retryAfter := 0
-		if val := resp.Header.Get(headerRetryAfter); val != "" {
-			if seconds, err2 := strconv.Atoi(val); err2 == nil {
-				retryAfter = seconds
-			}
-		}
-		// Indicate to our caller to pause for the specified number of seconds.
-		return exporterhelper.NewThrottleRetry(formattedErr, time.Duration(retryAfter)*time.Second)
+		retryAfter := parseRetryAfter(resp.Header.Get(headerRetryAfter), time.Now())
+		// Indicate to our caller to pause for the specified duration.
+		return exporterhelper.NewThrottleRetry(formattedErr, retryAfter)
 	}
 
 	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
@@ -283,33 +508,107 @@ func (exporter *logzioExporter) export(ctx context.Context, url string, request
 	return formattedErr
 }
 
-// Read the response and decode the status.Status from the body.
-// Returns nil if the response is empty or cannot be decoded.
-func readResponse(resp *http.Response) *status.Status {
-	var respStatus *status.Status
-	if resp.StatusCode >= 400 && resp.StatusCode <= 599 {
-		// Request failed. Read the body. OTLP spec says:
-		// "Response body for all HTTP 4xx and HTTP 5xx responses MUST be a
-		// Protobuf-encoded Status message that describes the problem."
-		maxRead := resp.ContentLength
-		if maxRead == -1 || maxRead > maxHTTPResponseReadBytes {
-			maxRead = maxHTTPResponseReadBytes
-		}
-		respBytes := make([]byte, maxRead)
-		n, err := io.ReadFull(resp.Body, respBytes)
-		if err == nil && n > 0 {
-			// Decode it as Status struct. See https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/protocol/otlp.md#failures
-			respStatus = &status.Status{}
-			err = proto.Unmarshal(respBytes, respStatus)
-			if err != nil {
-				respStatus = nil
-			}
-		}
+// parseRetryAfter returns the delay requested by a Retry-After header value, which is either a number
+// of seconds or an HTTP-date. Missing, invalid and past values result in no delay.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
 	}
+	return 0
+}
+
+// recordExport records the telemetry of a request sent to the destination host. The token is part of the
+// endpoint query, so only the host is used as the destination.
+func (exporter *logzioExporter) recordExport(ctx context.Context, destination string, documents int64, duration time.Duration, success bool) {
+	outcome := "success"
+	if !success {
+		outcome = "failure"
+	}
+	exporter.telemetryBuilder.LogzioexporterRequestDuration.Record(ctx, duration.Milliseconds(),
+		metric.WithAttributes(attribute.String("destination", destination), attribute.String("outcome", outcome)))
+
+	destinationAttr := metric.WithAttributes(attribute.String("destination", destination))
+	if success {
+		exporter.telemetryBuilder.LogzioexporterDocumentsSent.Add(ctx, documents, destinationAttr)
+	} else {
+		exporter.telemetryBuilder.LogzioexporterDocumentsFailed.Add(ctx, documents, destinationAttr)
+	}
+}
 
+// readResponseBody reads the body of a failed response, up to maxHTTPResponseReadBytes.
+// Returns nil if the request succeeded or the body cannot be read.
+func readResponseBody(resp *http.Response) []byte {
+	if resp.StatusCode < 400 || resp.StatusCode > 599 {
+		return nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseReadBytes))
+	if err != nil {
+		return nil
+	}
+	return body
+}
+
+// decodeStatus decodes the status.Status from a response body.
+// Returns nil if the body is empty or cannot be decoded.
+func decodeStatus(body []byte) *status.Status {
+	if len(body) == 0 {
+		return nil
+	}
+	// OTLP spec says: "Response body for all HTTP 4xx and HTTP 5xx responses MUST be a
+	// Protobuf-encoded Status message that describes the problem."
+	// See https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/protocol/otlp.md#failures
+	respStatus := &status.Status{}
+	if err := proto.Unmarshal(body, respStatus); err != nil {
+		return nil
+	}
 	return respStatus
 }
 
+// maxLoggedReasons is the number of rejection reasons logged for a rejected request.
+const maxLoggedReasons = 3
+
+// listenerResponse is the JSON body returned by the Logz.io listener when it rejects lines of a request.
+type listenerResponse struct {
+	MalformedLines  *int     `json:"malformedLines"`
+	SuccessfulLines int      `json:"successfulLines"`
+	OversizedLines  int      `json:"oversizedLines"`
+	EmptyLogLines   int      `json:"emptyLogLines"`
+	Reasons         []string `json:"reasons"`
+}
+
+// parseListenerResponse decodes the listener response of a bad request.
+// Returns nil if the response is not a bad request or the body is not a listener response.
+func parseListenerResponse(statusCode int, body []byte) *listenerResponse {
+	if statusCode != http.StatusBadRequest || len(body) == 0 {
+		return nil
+	}
+	var listenerResp listenerResponse
+	if err := json.Unmarshal(body, &listenerResp); err != nil || listenerResp.MalformedLines == nil {
+		return nil
+	}
+	return &listenerResp
+}
+
+// logRejectedLines logs the lines rejected by the listener along with the first rejection reasons.
+func (exporter *logzioExporter) logRejectedLines(listenerResp *listenerResponse) {
+	reasons := listenerResp.Reasons
+	if len(reasons) > maxLoggedReasons {
+		reasons = reasons[:maxLoggedReasons]
+	}
+	exporter.logger.Warn("Logz.io listener rejected lines of the request",
+		"malformed_lines", *listenerResp.MalformedLines,
+		"successful_lines", listenerResp.SuccessfulLines,
+		"oversized_lines", listenerResp.OversizedLines,
+		"empty_log_lines", listenerResp.EmptyLogLines,
+		"reasons", strings.Join(reasons, "; "))
+}
+
 func (exporter *logzioExporter) dropEmptyTags(tags []model.KeyValue) []model.KeyValue {
 	for i, tag := range tags {
 		if tag.Key == "" {
@@ -12,7 +12,11 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -21,14 +25,21 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/testdata"
 	conventions "go.opentelemetry.io/collector/semconv/v1.27.0"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter/internal/metadatatest"
 )
 
 const (
@@ -208,6 +219,57 @@ func TestExportErrors(tester *testing.T) {
 	}
 }
 
+func TestExportListenerBadRequest(t *testing.T) {
+	body, err := os.ReadFile(filepath.Join("testdata", "listener_bad_request_response.json"))
+	require.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusBadRequest)
+		_, _ = rw.Write(body)
+	}))
+	defer server.Close()
+
+	core, observed := observer.New(zap.WarnLevel)
+	params := exportertest.NewNopSettings(metadata.Type)
+	params.Logger = zap.New(core)
+	cfg := &Config{
+		Token:        "token",
+		ClientConfig: confighttp.NewDefaultClientConfig(),
+	}
+	exporter, err := newLogzioExporter(cfg, params)
+	require.NoError(t, err)
+	require.NoError(t, exporter.start(context.Background(), componenttest.NewNopHost()))
+
+	err = exporter.export(context.Background(), server.URL, []byte("{}"), 10, nil)
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.Contains(t, err.Error(), "MalformedLines=4")
+
+	entries := observed.FilterMessage("Logz.io listener rejected lines of the request").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, map[string]any{
+		"malformed_lines":  "4",
+		"successful_lines": "6",
+		"oversized_lines":  "0",
+		"empty_log_lines":  "0",
+		"reasons": "line 1: failed to parse JSON, unexpected character ('}' (code 125)) at position 37; " +
+			"line 3: field [@timestamp] is not a valid date; " +
+			"line 4: field [@timestamp] is not a valid date",
+	}, entries[0].ContextMap())
+}
+
+func TestParseListenerResponse(t *testing.T) {
+	assert.Nil(t, parseListenerResponse(http.StatusBadRequest, nil))
+	assert.Nil(t, parseListenerResponse(http.StatusBadRequest, []byte("not json")))
+	assert.Nil(t, parseListenerResponse(http.StatusBadRequest, []byte(`{"message":"bad request"}`)))
+	assert.Nil(t, parseListenerResponse(http.StatusInternalServerError, []byte(`{"malformedLines":1}`)))
+
+	listenerResp := parseListenerResponse(http.StatusBadRequest, []byte(`{"malformedLines":0,"oversizedLines":2}`))
+	require.NotNil(t, listenerResp)
+	assert.Equal(t, 0, *listenerResp.MalformedLines)
+	assert.Equal(t, 2, listenerResp.OversizedLines)
+}
+
 func TestNullTracesExporterConfig(tester *testing.T) {
 	params := exportertest.NewNopSettings(metadata.Type)
 	_, err := newLogzioTracesExporter(nil, params)
@@ -304,6 +366,367 @@ func TestPushLogsData(tester *testing.T) {
 	assert.Equal(tester, 45.0, jsonLog["23"])
 }
 
+func TestPushLogsDataSourceType(tester *testing.T) {
+	var recordedRequests []byte
+	var recordedType string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		recordedRequests, _ = io.ReadAll(req.Body)
+		recordedType = req.URL.Query().Get("type")
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = server.URL
+	clientConfig.Compression = configcompression.TypeGzip
+	cfg := Config{
+		Token:        "token",
+		ClientConfig: clientConfig,
+		SourceType:   "nginx",
+	}
+	ld := generateLogsOneEmptyTimestamp()
+	err := testLogsExporter(tester, ld, &cfg)
+	require.NoError(tester, err)
+	assert.Equal(tester, "nginx", recordedType)
+	var jsonLog map[string]any
+	decoded, _ := gUnzipData(recordedRequests)
+	requests := strings.Split(string(decoded), "\n")
+	assert.NoError(tester, json.Unmarshal([]byte(requests[0]), &jsonLog))
+	assert.Equal(tester, "nginx", jsonLog["type"])
+}
+
+func TestSourceTypeOnlyAppliesToLogs(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		queries = append(queries, req.URL.Query())
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = server.URL + "/?token=token"
+	cfg := &Config{
+		Token:        "token",
+		ClientConfig: clientConfig,
+		SourceType:   "nginx",
+	}
+	ctx := context.Background()
+	params := exportertest.NewNopSettings(metadata.Type)
+	// The collector shares the config between the exporters of the same component.
+	for range 2 {
+		logsExporter, err := createLogsExporter(ctx, params, cfg)
+		require.NoError(t, err)
+		require.NoError(t, logsExporter.Start(ctx, componenttest.NewNopHost()))
+		require.NoError(t, logsExporter.ConsumeLogs(ctx, generateLogsOneEmptyTimestamp()))
+		require.NoError(t, logsExporter.Shutdown(ctx))
+	}
+	tracesExporter, err := createTracesExporter(ctx, params, cfg)
+	require.NoError(t, err)
+	require.NoError(t, tracesExporter.Start(ctx, componenttest.NewNopHost()))
+	require.NoError(t, tracesExporter.ConsumeTraces(ctx, newTestTraces()))
+	require.NoError(t, tracesExporter.Shutdown(ctx))
+
+	assert.Equal(t, server.URL+"/?token=token", cfg.Endpoint)
+	require.Len(t, queries, 3)
+	assert.Equal(t, []string{"nginx"}, queries[0]["type"])
+	assert.Equal(t, []string{"nginx"}, queries[1]["type"])
+	assert.NotContains(t, queries[2], "type")
+}
+
+func TestPushLogsDataTypeFromResourceAttribute(t *testing.T) {
+	var recordedRequests []byte
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		recordedRequests, _ = io.ReadAll(req.Body)
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = server.URL
+	cfg := Config{
+		Token:            "token",
+		ClientConfig:     clientConfig,
+		LogType:          "default",
+		LogTypeAttribute: "logzio.type",
+	}
+	ld := plog.NewLogs()
+	nginx := ld.ResourceLogs().AppendEmpty()
+	nginx.Resource().Attributes().PutStr("logzio.type", "nginx")
+	nginxScope := nginx.ScopeLogs().AppendEmpty()
+	// The same key at the scope level is merged with the resource one, only the resource value is a type.
+	nginxScope.Scope().Attributes().PutStr("logzio.type", "ignored")
+	nginxScope.LogRecords().AppendEmpty().Body().SetStr("GET /")
+	other := ld.ResourceLogs().AppendEmpty()
+	otherRecord := other.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	otherRecord.Body().SetStr("started")
+	otherRecord.Attributes().PutStr("logzio.type", "ignored")
+
+	require.NoError(t, testLogsExporter(t, ld, &cfg))
+	requests := strings.Split(strings.TrimSuffix(string(recordedRequests), "\n"), "\n")
+	require.Len(t, requests, 2)
+	var jsonLog map[string]any
+	require.NoError(t, json.Unmarshal([]byte(requests[0]), &jsonLog))
+	assert.Equal(t, "nginx", jsonLog["type"])
+	require.NoError(t, json.Unmarshal([]byte(requests[1]), &jsonLog))
+	assert.Equal(t, "default", jsonLog["type"])
+}
+
+func TestPushLogsDataDropEmptyRecords(t *testing.T) {
+	tests := []struct {
+		name              string
+		dropEmptyRecords  bool
+		expectedDocuments int
+	}{
+		{
+			name:              "disabled",
+			expectedDocuments: 4,
+		},
+		{
+			name:              "enabled",
+			dropEmptyRecords:  true,
+			expectedDocuments: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recordedRequests []byte
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				recordedRequests, _ = io.ReadAll(req.Body)
+				rw.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			tel := componenttest.NewTelemetry()
+			defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+			clientConfig := confighttp.NewDefaultClientConfig()
+			clientConfig.Endpoint = server.URL
+			cfg := &Config{
+				Token:            "token",
+				ClientConfig:     clientConfig,
+				DropEmptyRecords: tt.dropEmptyRecords,
+			}
+			ld := generateLogsOneEmptyTimestamp()
+			logs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+			logs.AppendEmpty()
+			logs.AppendEmpty().Body().SetStr("")
+
+			exporter, err := newLogzioLogsExporter(cfg, metadatatest.NewSettings(tel))
+			require.NoError(t, err)
+			require.NoError(t, exporter.Start(context.Background(), componenttest.NewNopHost()))
+			require.NoError(t, exporter.ConsumeLogs(context.Background(), ld))
+			require.NoError(t, exporter.Shutdown(context.Background()))
+
+			requests := strings.Split(strings.TrimSuffix(string(recordedRequests), "\n"), "\n")
+			assert.Len(t, requests, tt.expectedDocuments)
+			if tt.dropEmptyRecords {
+				metadatatest.AssertEqualLogzioexporterRecordsDropped(t, tel,
+					[]metricdata.DataPoint[int64]{{Value: 2}},
+					metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+			}
+		})
+	}
+}
+
+func TestPushLogsDataDrainInterval(t *testing.T) {
+	var requests atomic.Int32
+	var documents atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		requests.Add(1)
+		documents.Add(int32(strings.Count(string(body), "\n")))
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = server.URL
+	cfg := &Config{
+		Token:         "token",
+		ClientConfig:  clientConfig,
+		DrainInterval: 1,
+	}
+	exporter, err := newLogzioLogsExporter(cfg, exportertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+	require.NoError(t, exporter.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, exporter.ConsumeLogs(context.Background(), testdata.GenerateLogs(3)))
+	require.NoError(t, exporter.ConsumeLogs(context.Background(), testdata.GenerateLogs(2)))
+
+	// Both batches are held until the drain interval elapses and are then flushed in a single request.
+	assert.Zero(t, requests.Load())
+	assert.Eventually(t, func() bool { return requests.Load() == 1 }, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, int32(5), documents.Load())
+	require.NoError(t, exporter.Shutdown(context.Background()))
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestDrainIntervalSharedConfig(t *testing.T) {
+	core, observed := observer.New(zap.WarnLevel)
+	params := exportertest.NewNopSettings(metadata.Type)
+	params.Logger = zap.New(core)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Token = "token"
+	cfg.MetricsToken = "metrics-token"
+	cfg.Region = "eu"
+	cfg.DrainInterval = 1
+
+	// The exporters of all the signals are created from the same config.
+	_, err := createLogsExporter(context.Background(), params, cfg)
+	require.NoError(t, err)
+	_, err = createTracesExporter(context.Background(), params, cfg)
+	require.NoError(t, err)
+	_, err = createMetricsExporter(context.Background(), params, cfg)
+	require.NoError(t, err)
+
+	assert.Nil(t, cfg.QueueSettings.Batch)
+	assert.Equal(t, 3, observed.FilterMessage("Mapping `drain_interval` -> `QueueSettings.Batch.FlushTimeout`").Len())
+	assert.Zero(t, observed.FilterMessage("Ignoring `drain_interval` as `sending_queue::batch` is configured").Len())
+}
+
+func TestIsEmptyLogRecord(t *testing.T) {
+	log := plog.NewLogRecord()
+	assert.True(t, isEmptyLogRecord(log))
+	log.Body().SetEmptyMap()
+	assert.True(t, isEmptyLogRecord(log))
+	log.Body().SetStr("hello")
+	assert.False(t, isEmptyLogRecord(log))
+	log.Body().SetStr("")
+	log.Attributes().PutStr("foo", "bar")
+	assert.False(t, isEmptyLogRecord(log))
+}
+
+func TestExportTelemetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		metricName string
+	}{
+		{
+			name:       "sent",
+			statusCode: http.StatusOK,
+			metricName: "otelcol_logzioexporter_documents_sent",
+		},
+		{
+			name:       "failed",
+			statusCode: http.StatusBadRequest,
+			metricName: "otelcol_logzioexporter_documents_failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+				rw.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+			serverURL, err := url.Parse(server.URL)
+			require.NoError(t, err)
+
+			tel := componenttest.NewTelemetry()
+			defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+			clientConfig := confighttp.NewDefaultClientConfig()
+			clientConfig.Endpoint = server.URL
+			cfg := &Config{
+				Token:        "token",
+				ClientConfig: clientConfig,
+			}
+			exporter, err := newLogzioLogsExporter(cfg, metadatatest.NewSettings(tel))
+			require.NoError(t, err)
+			require.NoError(t, exporter.Start(context.Background(), componenttest.NewNopHost()))
+			_ = exporter.ConsumeLogs(context.Background(), generateLogsOneEmptyTimestamp())
+			require.NoError(t, exporter.Shutdown(context.Background()))
+
+			destination := attribute.NewSet(attribute.String("destination", serverURL.Host))
+			documents, err := tel.GetMetric(tt.metricName)
+			require.NoError(t, err)
+			metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: 2, Attributes: destination}},
+			}, documents.Data, metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+
+			duration, err := tel.GetMetric("otelcol_logzioexporter_request_duration")
+			require.NoError(t, err)
+			histogram := duration.Data.(metricdata.Histogram[int64])
+			require.Len(t, histogram.DataPoints, 1)
+			assert.Equal(t, uint64(1), histogram.DataPoints[0].Count)
+			outcome, _ := histogram.DataPoints[0].Attributes.Value("outcome")
+			assert.Equal(t, tt.name == "sent", outcome.AsString() == "success")
+		})
+	}
+}
+
+func TestExportThrottled(t *testing.T) {
+	retryAt := time.Now().Add(time.Hour).UTC()
+	tests := []struct {
+		name       string
+		statusCode int
+		retryAfter string
+		minDelay   time.Duration
+	}{
+		{
+			name:       "too many requests with seconds",
+			statusCode: http.StatusTooManyRequests,
+			retryAfter: "30",
+			minDelay:   30 * time.Second,
+		},
+		{
+			name:       "service unavailable with http date",
+			statusCode: http.StatusServiceUnavailable,
+			retryAfter: retryAt.Format(http.TimeFormat),
+			minDelay:   59 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+				rw.Header().Set(headerRetryAfter, tt.retryAfter)
+				rw.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			clientConfig := confighttp.NewDefaultClientConfig()
+			clientConfig.Endpoint = server.URL
+			cfg := &Config{
+				Token:        "token",
+				ClientConfig: clientConfig,
+			}
+			exporter, err := newLogzioExporter(cfg, exportertest.NewNopSettings(metadata.Type))
+			require.NoError(t, err)
+			require.NoError(t, exporter.start(context.Background(), componenttest.NewNopHost()))
+
+			err = exporter.export(context.Background(), server.URL, []byte("{}"), 1, nil)
+			require.Error(t, err)
+			assert.False(t, consumererror.IsPermanent(err))
+			// the throttle delay is only exposed through the error message
+			msg, found := strings.CutPrefix(err.Error(), "Throttle (")
+			require.True(t, found, err.Error())
+			delay, err := time.ParseDuration(msg[:strings.Index(msg, ")")])
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, delay, tt.minDelay)
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{name: "missing", value: "", expected: 0},
+		{name: "seconds", value: "120", expected: 2 * time.Minute},
+		{name: "negative seconds", value: "-5", expected: 0},
+		{name: "http date", value: "Fri, 01 Mar 2024 12:00:45 GMT", expected: 45 * time.Second},
+		{name: "past http date", value: "Fri, 01 Mar 2024 11:00:00 GMT", expected: 0},
+		{name: "invalid", value: "soon", expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseRetryAfter(tt.value, now))
+		})
+	}
+}
+
 func TestMergeMapEntries(tester *testing.T) {
 	firstMap := pcommon.NewMap()
 	secondMap := pcommon.NewMap()
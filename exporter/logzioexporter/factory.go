@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
@@ -28,7 +29,8 @@ func NewFactory() exporter.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
-		exporter.WithLogs(createLogsExporter, metadata.LogsStability))
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -47,28 +49,32 @@ func createDefaultConfig() component.Config {
 	}
 }
 
-func getListenerURL(region string) string {
-	var url string
-	lowerCaseRegion := strings.ToLower(region)
-	switch lowerCaseRegion {
-	case "us":
-		url = "https://listener.logz.io:8071"
-	case "ca":
-		url = "https://listener-ca.logz.io:8071"
-	case "eu":
-		url = "https://listener-eu.logz.io:8071"
-	case "uk":
-		url = "https://listener-uk.logz.io:8071"
-	case "au":
-		url = "https://listener-au.logz.io:8071"
-	case "nl":
-		url = "https://listener-nl.logz.io:8071"
-	case "wa":
-		url = "https://listener-wa.logz.io:8071"
-	default:
-		url = "https://listener.logz.io:8071"
+// listenerHosts maps each Logz.io region to the host of its listener
+var listenerHosts = map[string]string{
+	"us": "listener.logz.io",
+	"ca": "listener-ca.logz.io",
+	"eu": "listener-eu.logz.io",
+	"uk": "listener-uk.logz.io",
+	"au": "listener-au.logz.io",
+	"nl": "listener-nl.logz.io",
+	"wa": "listener-wa.logz.io",
+}
+
+// listenerHost returns the host of the listener of the region, the US listener is used for unknown regions
+func listenerHost(region string) string {
+	if host, ok := listenerHosts[strings.ToLower(region)]; ok {
+		return host
 	}
-	return url
+	return listenerHosts["us"]
+}
+
+func getListenerURL(region string) string {
+	return fmt.Sprintf("https://%s:8071", listenerHost(region))
+}
+
+// getMetricsListenerURL returns the URL of the Prometheus remote write listener of the region
+func getMetricsListenerURL(region string) string {
+	return fmt.Sprintf("https://%s:8053", listenerHost(region))
 }
 
 func generateEndpoint(cfg *Config) (string, error) {
@@ -85,6 +91,37 @@ func generateEndpoint(cfg *Config) (string, error) {
 	}
 }
 
+// withSourceType sets the Logz.io listener `type` query parameter on the endpoint, if a source type is configured
+func withSourceType(endpoint string, sourceType string) (string, error) {
+	if sourceType == "" {
+		return endpoint, nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+	query := u.Query()
+	query.Set("type", sourceType)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// withToken sets the Logz.io listener `token` query parameter on the endpoint, if a token is resolved
+// for the request. Otherwise the endpoint keeps the configured `account_token`.
+func withToken(endpoint string, token string) (string, error) {
+	if token == "" {
+		return endpoint, nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
 func createTracesExporter(_ context.Context, params exporter.Settings, cfg component.Config) (exporter.Traces, error) {
 	exporterConfig := cfg.(*Config)
 	return newLogzioTracesExporter(exporterConfig, params)
@@ -94,3 +131,8 @@ func createLogsExporter(_ context.Context, params exporter.Settings, cfg compone
 	exporterConfig := cfg.(*Config)
 	return newLogzioLogsExporter(exporterConfig, params)
 }
+
+func createMetricsExporter(_ context.Context, params exporter.Settings, cfg component.Config) (exporter.Metrics, error) {
+	exporterConfig := cfg.(*Config)
+	return newLogzioMetricsExporter(exporterConfig, params)
+}
@@ -41,6 +41,25 @@ func TestCreateTraces(t *testing.T) {
 	assert.NotNil(t, exporter)
 }
 
+func TestCreateExportersRequireSignalToken(t *testing.T) {
+	factory := NewFactory()
+	params := exportertest.NewNopSettings(metadata.Type)
+
+	metricsOnly := factory.CreateDefaultConfig().(*Config)
+	metricsOnly.MetricsToken = "metrics-token"
+	_, err := factory.CreateTraces(context.Background(), params, metricsOnly)
+	assert.EqualError(t, err, "`account_token` is required to export traces")
+	_, err = factory.CreateLogs(context.Background(), params, metricsOnly)
+	assert.EqualError(t, err, "`account_token` is required to export logs")
+	_, err = factory.CreateMetrics(context.Background(), params, metricsOnly)
+	assert.NoError(t, err)
+
+	accountOnly := factory.CreateDefaultConfig().(*Config)
+	accountOnly.Token = "token"
+	_, err = factory.CreateMetrics(context.Background(), params, accountOnly)
+	assert.EqualError(t, err, "`metrics_token` is required to export metrics")
+}
+
 func TestGenerateUrl(t *testing.T) {
 	type generateURLTest struct {
 		endpoint string
@@ -71,6 +90,19 @@ func TestGenerateUrl(t *testing.T) {
 	}
 }
 
+func TestWithSourceType(t *testing.T) {
+	endpoint, err := withSourceType("https://listener.logz.io:8071/?token=token", "")
+	require.NoError(t, err)
+	require.Equal(t, "https://listener.logz.io:8071/?token=token", endpoint)
+
+	endpoint, err = withSourceType("https://listener.logz.io:8071/?token=token", "nginx")
+	require.NoError(t, err)
+	require.Equal(t, "https://listener.logz.io:8071/?token=token&type=nginx", endpoint)
+
+	_, err = withSourceType("https://listener.logz.io:8071/%zz", "nginx")
+	require.Error(t, err)
+}
+
 func TestGetListenerURL(t *testing.T) {
 	type getListenerURLTest struct {
 		arg1     string
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logzioexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter"
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// logsGroup holds the resource logs sending the same request headers to the same account
+type logsGroup struct {
+	token  string
+	header http.Header
+	logs   plog.Logs
+}
+
+// tracesGroup holds the resource spans sending the same request headers to the same account
+type tracesGroup struct {
+	token  string
+	header http.Header
+	traces ptrace.Traces
+}
+
+// tokenFromResource returns the account token read from the `token_from_attribute` resource attribute,
+// or an empty string when the configured `account_token` applies
+func tokenFromResource(resource pcommon.Resource, tokenFromAttribute string) string {
+	if tokenFromAttribute == "" {
+		return ""
+	}
+	if value, ok := resource.Attributes().Get(tokenFromAttribute); ok {
+		return value.AsString()
+	}
+	return ""
+}
+
+// headersFromResource returns the request headers set from the resource attributes, as configured
+// in `headers_from_attributes`. Attributes missing from the resource are skipped.
+func headersFromResource(resource pcommon.Resource, headersFromAttributes map[string]string) http.Header {
+	header := http.Header{}
+	for name, key := range headersFromAttributes {
+		if value, ok := resource.Attributes().Get(key); ok {
+			header.Set(name, value.AsString())
+		}
+	}
+	return header
+}
+
+// headersKey returns a key identifying the header values, used to group resources sending the same headers
+func headersKey(header http.Header) string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var key strings.Builder
+	for _, name := range names {
+		key.WriteString(name)
+		key.WriteByte('=')
+		key.WriteString(header.Get(name))
+		key.WriteByte('\n')
+	}
+	return key.String()
+}
+
+// groupLogsByRequest splits the logs by the account token and request headers set from their resource attributes
+func groupLogsByRequest(ld plog.Logs, headersFromAttributes map[string]string, tokenFromAttribute string) []logsGroup {
+	var groups []logsGroup
+	index := map[string]int{}
+	resourceLogs := ld.ResourceLogs()
+	for i := 0; i < resourceLogs.Len(); i++ {
+		resource := resourceLogs.At(i).Resource()
+		token := tokenFromResource(resource, tokenFromAttribute)
+		header := headersFromResource(resource, headersFromAttributes)
+		key := token + "\n" + headersKey(header)
+		j, ok := index[key]
+		if !ok {
+			j = len(groups)
+			index[key] = j
+			groups = append(groups, logsGroup{token: token, header: header, logs: plog.NewLogs()})
+		}
+		resourceLogs.At(i).CopyTo(groups[j].logs.ResourceLogs().AppendEmpty())
+	}
+	return groups
+}
+
+// groupTracesByRequest splits the traces by the account token and request headers set from their resource attributes
+func groupTracesByRequest(traces ptrace.Traces, headersFromAttributes map[string]string, tokenFromAttribute string) []tracesGroup {
+	var groups []tracesGroup
+	index := map[string]int{}
+	resourceSpans := traces.ResourceSpans()
+	for i := 0; i < resourceSpans.Len(); i++ {
+		resource := resourceSpans.At(i).Resource()
+		token := tokenFromResource(resource, tokenFromAttribute)
+		header := headersFromResource(resource, headersFromAttributes)
+		key := token + "\n" + headersKey(header)
+		j, ok := index[key]
+		if !ok {
+			j = len(groups)
+			index[key] = j
+			groups = append(groups, tracesGroup{token: token, header: header, traces: ptrace.NewTraces()})
+		}
+		resourceSpans.At(i).CopyTo(groups[j].traces.ResourceSpans().AppendEmpty())
+	}
+	return groups
+}
@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logzioexporter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter/internal/metadata"
+)
+
+var testHeadersFromAttributes = map[string]string{
+	"X-Tenant": "tenant",
+	"X-Env":    "deployment.environment",
+}
+
+func TestGroupLogsByHeaders(t *testing.T) {
+	ld := plog.NewLogs()
+	for _, tenant := range []string{"a", "b", "a", ""} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		if tenant != "" {
+			rl.Resource().Attributes().PutStr("tenant", tenant)
+		}
+		rl.Resource().Attributes().PutInt("deployment.environment", 1)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(tenant)
+	}
+
+	groups := groupLogsByRequest(ld, testHeadersFromAttributes, "")
+	require.Len(t, groups, 3)
+	assert.Equal(t, http.Header{"X-Tenant": {"a"}, "X-Env": {"1"}}, groups[0].header)
+	assert.Equal(t, 2, groups[0].logs.ResourceLogs().Len())
+	assert.Equal(t, http.Header{"X-Tenant": {"b"}, "X-Env": {"1"}}, groups[1].header)
+	assert.Equal(t, 1, groups[1].logs.ResourceLogs().Len())
+	// The header is not set when the resource lacks the attribute.
+	assert.Equal(t, http.Header{"X-Env": {"1"}}, groups[2].header)
+	assert.Equal(t, 1, groups[2].logs.ResourceLogs().Len())
+}
+
+func TestGroupTracesByHeaders(t *testing.T) {
+	traces := ptrace.NewTraces()
+	for _, tenant := range []string{"a", "b", "a"} {
+		rs := traces.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("tenant", tenant)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(tenant)
+	}
+
+	groups := groupTracesByRequest(traces, testHeadersFromAttributes, "")
+	require.Len(t, groups, 2)
+	assert.Equal(t, http.Header{"X-Tenant": {"a"}}, groups[0].header)
+	assert.Equal(t, 2, groups[0].traces.ResourceSpans().Len())
+	assert.Equal(t, http.Header{"X-Tenant": {"b"}}, groups[1].header)
+	assert.Equal(t, 1, groups[1].traces.ResourceSpans().Len())
+}
+
+func TestPushLogsDataHeadersFromAttributes(t *testing.T) {
+	var mu sync.Mutex
+	var tenants []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		tenant := req.Header.Get("X-Tenant")
+		tenants = append(tenants, tenant)
+		if tenant == "b" {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = server.URL
+	cfg := &Config{
+		Token:                 "token",
+		ClientConfig:          clientConfig,
+		HeadersFromAttributes: testHeadersFromAttributes,
+	}
+	exporter, err := newLogzioExporter(cfg, exportertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+	require.NoError(t, exporter.start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	for _, tenant := range []string{"a", "b", "a"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("tenant", tenant)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("hello")
+	}
+	err = exporter.pushLogData(context.Background(), ld)
+	require.Error(t, err)
+	assert.ElementsMatch(t, []string{"a", "b"}, tenants)
+
+	// Only the group rejected by the listener is retried.
+	var logsErr consumererror.Logs
+	require.True(t, errors.As(err, &logsErr))
+	failed := logsErr.Data()
+	require.Equal(t, 1, failed.ResourceLogs().Len())
+	tenant, _ := failed.ResourceLogs().At(0).Resource().Attributes().Get("tenant")
+	assert.Equal(t, "b", tenant.Str())
+}
+
+func TestPushGroupsPermanentErrors(t *testing.T) {
+	statuses := map[string]int{
+		"ok":        http.StatusOK,
+		"retryable": http.StatusInternalServerError,
+		"permanent": http.StatusBadRequest,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(statuses[req.Header.Get("X-Tenant")])
+	}))
+	defer server.Close()
+
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = server.URL
+	cfg := &Config{
+		Token:                 "token",
+		ClientConfig:          clientConfig,
+		HeadersFromAttributes: testHeadersFromAttributes,
+	}
+	exporter, err := newLogzioExporter(cfg, exportertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+	require.NoError(t, exporter.start(context.Background(), componenttest.NewNopHost()))
+
+	newLogs := func(tenants ...string) plog.Logs {
+		ld := plog.NewLogs()
+		for _, tenant := range tenants {
+			rl := ld.ResourceLogs().AppendEmpty()
+			rl.Resource().Attributes().PutStr("tenant", tenant)
+			rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("hello")
+		}
+		return ld
+	}
+	newTraces := func(tenants ...string) ptrace.Traces {
+		td := ptrace.NewTraces()
+		for _, tenant := range tenants {
+			rs := td.ResourceSpans().AppendEmpty()
+			rs.Resource().Attributes().PutStr("tenant", tenant)
+			rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+		}
+		return td
+	}
+
+	// Only permanent failures: the whole batch is dropped.
+	err = exporter.pushLogData(context.Background(), newLogs("ok", "permanent"))
+	assert.True(t, consumererror.IsPermanent(err))
+	err = exporter.pushTraceData(context.Background(), newTraces("ok", "permanent"))
+	assert.True(t, consumererror.IsPermanent(err))
+
+	// Mixed failures: only the retryable group is retried.
+	err = exporter.pushLogData(context.Background(), newLogs("ok", "retryable", "permanent"))
+	assert.False(t, consumererror.IsPermanent(err))
+	var logsErr consumererror.Logs
+	require.True(t, errors.As(err, &logsErr))
+	require.Equal(t, 1, logsErr.Data().ResourceLogs().Len())
+	tenant, _ := logsErr.Data().ResourceLogs().At(0).Resource().Attributes().Get("tenant")
+	assert.Equal(t, "retryable", tenant.Str())
+
+	err = exporter.pushTraceData(context.Background(), newTraces("ok", "retryable", "permanent"))
+	assert.False(t, consumererror.IsPermanent(err))
+	var tracesErr consumererror.Traces
+	require.True(t, errors.As(err, &tracesErr))
+	require.Equal(t, 1, tracesErr.Data().ResourceSpans().Len())
+	tenant, _ = tracesErr.Data().ResourceSpans().At(0).Resource().Attributes().Get("tenant")
+	assert.Equal(t, "retryable", tenant.Str())
+}
+
+func TestGroupLogsByToken(t *testing.T) {
+	ld := plog.NewLogs()
+	for _, token := range []string{"token-a", "token-b", "", "token-a"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		if token != "" {
+			rl.Resource().Attributes().PutStr("logzio.token", token)
+		}
+		rl.Resource().Attributes().PutStr("tenant", "a")
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(token)
+	}
+
+	groups := groupLogsByRequest(ld, testHeadersFromAttributes, "logzio.token")
+	require.Len(t, groups, 3)
+	assert.Equal(t, "token-a", groups[0].token)
+	assert.Equal(t, 2, groups[0].logs.ResourceLogs().Len())
+	assert.Equal(t, "token-b", groups[1].token)
+	assert.Equal(t, 1, groups[1].logs.ResourceLogs().Len())
+	// Resources lacking the attribute are sent with the configured account token.
+	assert.Empty(t, groups[2].token)
+	assert.Equal(t, 1, groups[2].logs.ResourceLogs().Len())
+	for _, group := range groups {
+		assert.Equal(t, http.Header{"X-Tenant": {"a"}}, group.header)
+	}
+}
+
+func TestPushLogsDataTokenFromAttribute(t *testing.T) {
+	var mu sync.Mutex
+	bodies := map[string][]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		token := req.URL.Query().Get("token")
+		for _, line := range strings.Split(strings.TrimSpace(string(body)), "\n") {
+			var doc map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(line), &doc))
+			bodies[token] = append(bodies[token], doc["message"].(string))
+		}
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = server.URL + "/?token=default"
+	cfg := &Config{
+		Token:              "default",
+		ClientConfig:       clientConfig,
+		TokenFromAttribute: "logzio.token",
+	}
+	exporter, err := newLogzioExporter(cfg, exportertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+	require.NoError(t, exporter.start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	for i, token := range []string{"token-a", "token-b", "token-a", ""} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		if token != "" {
+			rl.Resource().Attributes().PutStr("logzio.token", token)
+		}
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(fmt.Sprintf("%d:%s", i, token))
+	}
+	require.NoError(t, exporter.pushLogData(context.Background(), ld))
+
+	// Each request only holds the records of a single account.
+	assert.Equal(t, map[string][]string{
+		"token-a": {"0:token-a", "2:token-a"},
+		"token-b": {"1:token-b"},
+		"default": {"3:"},
+	}, bodies)
+}
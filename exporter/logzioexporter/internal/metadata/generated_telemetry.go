@@ -0,0 +1,104 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+func Meter(settings component.TelemetrySettings) metric.Meter {
+	return settings.MeterProvider.Meter("github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter")
+}
+
+func Tracer(settings component.TelemetrySettings) trace.Tracer {
+	return settings.TracerProvider.Tracer("github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter")
+}
+
+// TelemetryBuilder provides an interface for components to report telemetry
+// as defined in metadata and user config.
+type TelemetryBuilder struct {
+	meter                         metric.Meter
+	mu                            sync.Mutex
+	registrations                 []metric.Registration
+	LogzioexporterDocumentsFailed metric.Int64Counter
+	LogzioexporterDocumentsSent   metric.Int64Counter
+	LogzioexporterQueueFull       metric.Int64Counter
+	LogzioexporterRecordsDropped  metric.Int64Counter
+	LogzioexporterRecordsSampled  metric.Int64Counter
+	LogzioexporterRequestDuration metric.Int64Histogram
+}
+
+// TelemetryBuilderOption applies changes to default builder.
+type TelemetryBuilderOption interface {
+	apply(*TelemetryBuilder)
+}
+
+type telemetryBuilderOptionFunc func(mb *TelemetryBuilder)
+
+func (tbof telemetryBuilderOptionFunc) apply(mb *TelemetryBuilder) {
+	tbof(mb)
+}
+
+// Shutdown unregister all registered callbacks for async instruments.
+func (builder *TelemetryBuilder) Shutdown() {
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	for _, reg := range builder.registrations {
+		reg.Unregister()
+	}
+}
+
+// NewTelemetryBuilder provides a struct with methods to update all internal telemetry
+// for a component
+func NewTelemetryBuilder(settings component.TelemetrySettings, options ...TelemetryBuilderOption) (*TelemetryBuilder, error) {
+	builder := TelemetryBuilder{}
+	for _, op := range options {
+		op.apply(&builder)
+	}
+	builder.meter = Meter(settings)
+	var err, errs error
+	builder.LogzioexporterDocumentsFailed, err = builder.meter.Int64Counter(
+		"otelcol_logzioexporter_documents_failed",
+		metric.WithDescription("Number of documents that failed to be sent to Logz.io, by destination"),
+		metric.WithUnit("{documents}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LogzioexporterDocumentsSent, err = builder.meter.Int64Counter(
+		"otelcol_logzioexporter_documents_sent",
+		metric.WithDescription("Number of documents successfully sent to Logz.io, by destination"),
+		metric.WithUnit("{documents}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LogzioexporterQueueFull, err = builder.meter.Int64Counter(
+		"otelcol_logzioexporter_queue_full",
+		metric.WithDescription("Number of batches rejected by a full sending queue, by the `on_queue_full` policy applied to them"),
+		metric.WithUnit("{batches}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LogzioexporterRecordsDropped, err = builder.meter.Int64Counter(
+		"otelcol_logzioexporter_records_dropped",
+		metric.WithDescription("Number of empty log records dropped before being sent to Logz.io when `drop_empty_records` is enabled"),
+		metric.WithUnit("{records}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LogzioexporterRecordsSampled, err = builder.meter.Int64Counter(
+		"otelcol_logzioexporter_records_sampled",
+		metric.WithDescription("Number of log records below the `sampling` severity threshold dropped before being sent to Logz.io"),
+		metric.WithUnit("{records}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LogzioexporterRequestDuration, err = builder.meter.Int64Histogram(
+		"otelcol_logzioexporter_request_duration",
+		metric.WithDescription("Duration of the HTTP requests sent to Logz.io, by destination and outcome"),
+		metric.WithUnit("ms"),
+		metric.WithExplicitBucketBoundaries([]float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000}...),
+	)
+	errs = errors.Join(errs, err)
+	return &builder, errs
+}
@@ -11,7 +11,7 @@ import (
 )
 
 // convertLogRecordToJSON Takes `plog.LogRecord` and `pcommon.Resource` input, outputs byte array that represents the log record as json string
-func convertLogRecordToJSON(log plog.LogRecord, attributes pcommon.Map) map[string]any {
+func convertLogRecordToJSON(log plog.LogRecord, resource pcommon.Map, attributes pcommon.Map, cfg *Config) map[string]any {
 	jsonLog := map[string]any{}
 	if spanID := log.SpanID(); !spanID.IsEmpty() {
 		jsonLog["spanID"] = hex.EncodeToString(spanID[:])
@@ -24,12 +24,19 @@ func convertLogRecordToJSON(log plog.LogRecord, attributes pcommon.Map) map[stri
 	}
 	// try to set timestamp if exists
 	if log.Timestamp().AsTime().UnixMilli() != 0 {
-		jsonLog["@timestamp"] = log.Timestamp().AsTime().UnixMilli()
+		jsonLog[cfg.timestampField()] = cfg.formatTimestamp(log.Timestamp().AsTime())
 	}
 
-	// Add merged attributed to each json log
+	// records carrying their own type attribute or body field keep it
+	if logType := cfg.logType(resource, attributes); logType != "" {
+		jsonLog["type"] = logType
+	}
+
+	// Add merged attributed to each json log, mapped attributes being renamed below
 	for k, v := range attributes.All() {
-		jsonLog[k] = v.AsRaw()
+		if _, mapped := cfg.FieldMappings[k]; !mapped {
+			jsonLog[k] = v.AsRaw()
+		}
 	}
 
 	switch log.Body().Type() {
@@ -41,5 +48,12 @@ func convertLogRecordToJSON(log plog.LogRecord, attributes pcommon.Map) map[stri
 			jsonLog[key] = value
 		}
 	}
+
+	// mapped attributes are written last so that they take precedence over the fields they are renamed to
+	for source, target := range cfg.FieldMappings {
+		if v, ok := attributes.Get(source); ok {
+			jsonLog[target] = v.AsRaw()
+		}
+	}
 	return jsonLog
 }
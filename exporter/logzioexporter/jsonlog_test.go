@@ -11,6 +11,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -75,11 +76,213 @@ func TestConvertLogRecordToJSON(t *testing.T) {
 		},
 	}
 	for _, test := range convertLogRecordToJSONTests {
-		output := convertLogRecordToJSON(test.log, test.log.Attributes())
+		output := convertLogRecordToJSON(test.log, pcommon.NewMap(), test.log.Attributes(), &Config{})
 		require.Equal(t, test.expected, output)
 	}
 }
 
+func TestConvertLogRecordType(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        *Config
+		resource   map[string]any
+		attributes map[string]any
+		body       map[string]any
+		expected   any
+	}{
+		{
+			name:       "derived from attribute",
+			cfg:        &Config{TypeFromAttribute: "log.source"},
+			attributes: map[string]any{"log.source": "nginx"},
+			expected:   "nginx",
+		},
+		{
+			name:       "attribute takes precedence over the static type",
+			cfg:        &Config{TypeFromAttribute: "log.source", SourceType: "default"},
+			attributes: map[string]any{"log.source": "nginx"},
+			expected:   "nginx",
+		},
+		{
+			name:     "falls back to the static type when the attribute is missing",
+			cfg:      &Config{TypeFromAttribute: "log.source", SourceType: "default"},
+			expected: "default",
+		},
+		{
+			name:       "falls back to the static type when the attribute is empty",
+			cfg:        &Config{TypeFromAttribute: "log.source", SourceType: "default"},
+			attributes: map[string]any{"log.source": ""},
+			expected:   "default",
+		},
+		{
+			name: "not set without attribute and static type",
+			cfg:  &Config{TypeFromAttribute: "log.source"},
+		},
+		{
+			name:       "invalid attribute value falls back to the static type",
+			cfg:        &Config{TypeFromAttribute: "log.source", SourceType: "default"},
+			attributes: map[string]any{"log.source": []any{"nginx", "apache"}},
+			expected:   "default",
+		},
+		{
+			name:     "static log type",
+			cfg:      &Config{LogType: "java"},
+			expected: "java",
+		},
+		{
+			name:     "static log type takes precedence over the source type",
+			cfg:      &Config{LogType: "java", SourceType: "default"},
+			expected: "java",
+		},
+		{
+			name:       "derived from resource attribute",
+			cfg:        &Config{LogTypeAttribute: "logzio.type", LogType: "java"},
+			resource:   map[string]any{"logzio.type": "nginx"},
+			attributes: map[string]any{"logzio.type": "nginx"},
+			expected:   "nginx",
+		},
+		{
+			name:       "record attribute is not a resource attribute",
+			cfg:        &Config{LogTypeAttribute: "logzio.type", LogType: "java"},
+			attributes: map[string]any{"logzio.type": "nginx"},
+			expected:   "java",
+		},
+		{
+			name:       "invalid resource attribute value falls back to the static log type",
+			cfg:        &Config{LogTypeAttribute: "logzio.type", LogType: "java"},
+			resource:   map[string]any{"logzio.type": "nginx access"},
+			attributes: map[string]any{"logzio.type": "nginx access"},
+			expected:   "java",
+		},
+		{
+			name:     "record type field is kept",
+			cfg:      &Config{TypeFromAttribute: "log.source", SourceType: "default"},
+			body:     map[string]any{"type": "custom"},
+			expected: "custom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := plog.NewLogRecord()
+			require.NoError(t, lr.Attributes().FromRaw(tt.attributes))
+			if tt.body != nil {
+				require.NoError(t, lr.Body().SetEmptyMap().FromRaw(tt.body))
+			}
+			resource := pcommon.NewMap()
+			require.NoError(t, resource.FromRaw(tt.resource))
+			output := convertLogRecordToJSON(lr, resource, lr.Attributes(), tt.cfg)
+			if tt.expected == nil {
+				require.NotContains(t, output, "type")
+				return
+			}
+			require.Equal(t, tt.expected, output["type"])
+		})
+	}
+}
+
+func TestConvertLogRecordTimestamp(t *testing.T) {
+	ts := time.Date(2024, time.March, 5, 10, 20, 30, 123456789, time.UTC)
+	tests := []struct {
+		name     string
+		cfg      *Config
+		ts       time.Time
+		field    string
+		expected any
+	}{
+		{
+			name:     "default",
+			cfg:      &Config{},
+			ts:       ts,
+			field:    "@timestamp",
+			expected: ts.UnixMilli(),
+		},
+		{
+			name:     "epoch-ms",
+			cfg:      &Config{TimestampFormat: "epoch-ms"},
+			ts:       ts,
+			field:    "@timestamp",
+			expected: ts.UnixMilli(),
+		},
+		{
+			name:     "epoch-ns",
+			cfg:      &Config{TimestampFormat: "epoch-ns"},
+			ts:       ts,
+			field:    "@timestamp",
+			expected: ts.UnixNano(),
+		},
+		{
+			name:     "rfc3339 with custom field",
+			cfg:      &Config{TimestampField: "eventTime", TimestampFormat: "rfc3339"},
+			ts:       ts,
+			field:    "eventTime",
+			expected: "2024-03-05T10:20:30.123456789Z",
+		},
+		{
+			name:  "unset timestamp is left to receive time",
+			cfg:   &Config{TimestampField: "eventTime", TimestampFormat: "rfc3339"},
+			field: "eventTime",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := plog.NewLogRecord()
+			if !tt.ts.IsZero() {
+				lr.SetTimestamp(pcommon.NewTimestampFromTime(tt.ts))
+			}
+			output := convertLogRecordToJSON(lr, pcommon.NewMap(), lr.Attributes(), tt.cfg)
+			if tt.expected == nil {
+				require.NotContains(t, output, tt.field)
+				return
+			}
+			require.Equal(t, tt.expected, output[tt.field])
+		})
+	}
+}
+
+func TestConvertLogRecordFieldMappings(t *testing.T) {
+	cfg := &Config{
+		FieldMappings: map[string]string{
+			"msg":       "message",
+			"log.level": "level",
+			"source":    "type",
+			"missing":   "absent",
+		},
+	}
+	lr := plog.NewLogRecord()
+	lr.SetSeverityText("Info")
+	lr.Body().SetStr("")
+	require.NoError(t, lr.Attributes().FromRaw(map[string]any{
+		"msg":       "user logged in",
+		"log.level": "debug",
+		"source":    "auth",
+		"user":      "alice",
+	}))
+	output := convertLogRecordToJSON(lr, pcommon.NewMap(), lr.Attributes(), cfg)
+	require.Equal(t, map[string]any{
+		"message": "user logged in",
+		"level":   "debug",
+		"type":    "auth",
+		"user":    "alice",
+	}, output)
+}
+
+func TestFieldMappingsCollision(t *testing.T) {
+	cfg := &Config{
+		Token: "token",
+		FieldMappings: map[string]string{
+			"msg":     "message",
+			"log":     "message",
+			"service": "app",
+		},
+	}
+	require.EqualError(t, cfg.Validate(), "`field_mappings` maps both \"log\" and \"msg\" to \"message\"")
+
+	cfg.FieldMappings = map[string]string{"msg": ""}
+	require.EqualError(t, cfg.Validate(), "`field_mappings` maps \"msg\" to an empty field name")
+
+	cfg.FieldMappings = map[string]string{"msg": "message", "service": "app"}
+	require.NoError(t, cfg.Validate())
+}
+
 func TestSetTimeStamp(t *testing.T) {
 	var recordedRequests []byte
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
@@ -44,8 +44,9 @@ func getTagsValues(tags []model.KeyValue) []string {
 }
 
 // transformToLogzioSpanBytes receives a Jaeger span, converts it to logzio span and returns it as a byte array.
-// The main differences between Jaeger span and logzio span are arrays which are represented as maps
-func transformToLogzioSpanBytes(span *model.Span) ([]byte, error) {
+// The main differences between Jaeger span and logzio span are arrays which are represented as maps.
+// The service name is written under serviceNameField, which is either process.serviceName or a top level field.
+func transformToLogzioSpanBytes(span *model.Span, serviceNameField string) ([]byte, error) {
 	spanConverter := newFromDomain(true, getTagsValues(span.Tags), tagDotReplacementCharacter)
 	jsonSpan := spanConverter.fromDomainEmbedProcess(span)
 	newSpan := logzioSpan{
@@ -64,5 +65,32 @@ func transformToLogzioSpanBytes(span *model.Span) ([]byte, error) {
 		Logs:            jsonSpan.Logs,
 		Type:            spanLogType,
 	}
-	return json.Marshal(newSpan)
+	if serviceNameField == defaultServiceNameField {
+		return json.Marshal(newSpan)
+	}
+	return marshalWithServiceNameField(newSpan, serviceNameField)
+}
+
+// marshalWithServiceNameField marshals the span with the service name moved from the process to the given top level field
+func marshalWithServiceNameField(span logzioSpan, field string) ([]byte, error) {
+	spanBytes, err := json.Marshal(span)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]json.RawMessage
+	if err = json.Unmarshal(spanBytes, &doc); err != nil {
+		return nil, err
+	}
+	var process map[string]json.RawMessage
+	if err = json.Unmarshal(doc["process"], &process); err != nil {
+		return nil, err
+	}
+	delete(process, "serviceName")
+	if doc["process"], err = json.Marshal(process); err != nil {
+		return nil, err
+	}
+	if doc[field], err = json.Marshal(span.Process.ServiceName); err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
 }
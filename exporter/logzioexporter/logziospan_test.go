@@ -22,7 +22,7 @@ func TestTransformToLogzioSpanBytes(tester *testing.T) {
 	if err != nil {
 		fmt.Println("json.Unmarshal")
 	}
-	newSpan, err := transformToLogzioSpanBytes(&span)
+	newSpan, err := transformToLogzioSpanBytes(&span, defaultServiceNameField)
 	require.NoError(tester, err)
 	m := make(map[string]any)
 	err = json.Unmarshal(newSpan, &m)
@@ -31,3 +31,26 @@ func TestTransformToLogzioSpanBytes(tester *testing.T) {
 		tester.Error("error converting span to logzioSpan, JaegerTag is not found")
 	}
 }
+
+func TestTransformToLogzioSpanBytesServiceNameField(t *testing.T) {
+	span := model.Span{
+		OperationName: "op",
+		Process:       model.NewProcess("service-x", []model.KeyValue{model.String("host", "h1")}),
+	}
+	newSpan, err := transformToLogzioSpanBytes(&span, "service.name")
+	require.NoError(t, err)
+	m := make(map[string]any)
+	require.NoError(t, json.Unmarshal(newSpan, &m))
+	require.Equal(t, "service-x", m["service.name"])
+	process, ok := m["process"].(map[string]any)
+	require.True(t, ok)
+	require.NotContains(t, process, "serviceName")
+	require.Contains(t, process, "tag")
+
+	newSpan, err = transformToLogzioSpanBytes(&span, defaultServiceNameField)
+	require.NoError(t, err)
+	m = make(map[string]any)
+	require.NoError(t, json.Unmarshal(newSpan, &m))
+	require.NotContains(t, m, "service.name")
+	require.Equal(t, "service-x", m["process"].(map[string]any)["serviceName"])
+}
@@ -0,0 +1,25 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logzioexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter"
+
+import (
+	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+)
+
+// toWriteRequest converts the metrics to a Prometheus remote write request, the same way as the
+// prometheusremotewrite exporter. The metrics that cannot be converted, such as delta sums, are reported
+// in the returned error while the others are still part of the request.
+func toWriteRequest(md pmetric.Metrics) (*prompb.WriteRequest, error) {
+	tsMap, err := prometheusremotewrite.FromMetrics(md, prometheusremotewrite.Settings{AddMetricSuffixes: true})
+	request := &prompb.WriteRequest{
+		Timeseries: make([]prompb.TimeSeries, 0, len(tsMap)),
+	}
+	for _, ts := range tsMap {
+		request.Timeseries = append(request.Timeseries, *ts)
+	}
+	return request, err
+}
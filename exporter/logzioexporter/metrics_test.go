@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logzioexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter/internal/metadata"
+)
+
+var testMetricsTimestamp = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func newTestMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	rm.Resource().Attributes().PutStr("service.namespace", "shop")
+	rm.Resource().Attributes().PutStr("service.instance.id", "checkout-1")
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+	timestamp := pcommon.NewTimestampFromTime(testMetricsTimestamp)
+
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("system.cpu.load")
+	dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetDoubleValue(0.75)
+	dp.SetTimestamp(timestamp)
+	dp.Attributes().PutStr("host.name", "web-1")
+
+	counter := metrics.AppendEmpty()
+	counter.SetName("http.server.requests")
+	counterSum := counter.SetEmptySum()
+	counterSum.SetIsMonotonic(true)
+	counterSum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp = counterSum.DataPoints().AppendEmpty()
+	dp.SetIntValue(42)
+	dp.SetTimestamp(timestamp)
+	dp.Attributes().PutInt("http.status_code", 200)
+
+	upDownCounter := metrics.AppendEmpty()
+	upDownCounter.SetName("queue_size")
+	upDownCounterSum := upDownCounter.SetEmptySum()
+	upDownCounterSum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp = upDownCounterSum.DataPoints().AppendEmpty()
+	dp.SetIntValue(7)
+	dp.SetTimestamp(timestamp)
+
+	delta := metrics.AppendEmpty()
+	delta.SetName("delta.requests")
+	deltaSum := delta.SetEmptySum()
+	deltaSum.SetIsMonotonic(true)
+	deltaSum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	deltaSum.DataPoints().AppendEmpty().SetIntValue(1)
+
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("http.server.duration")
+	histogramData := histogram.SetEmptyHistogram()
+	histogramData.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	histogramDp := histogramData.DataPoints().AppendEmpty()
+	histogramDp.SetCount(3)
+	histogramDp.SetTimestamp(timestamp)
+
+	return md
+}
+
+func TestToWriteRequest(t *testing.T) {
+	request, err := toWriteRequest(newTestMetrics())
+	assert.ErrorContains(t, err, "delta.requests")
+
+	timestampMs := testMetricsTimestamp.UnixMilli()
+	series := seriesByName(request)
+	assert.Equal(t, []prompb.Label{
+		{Name: "__name__", Value: "system_cpu_load"},
+		{Name: "host_name", Value: "web-1"},
+		{Name: "instance", Value: "checkout-1"},
+		{Name: "job", Value: "shop/checkout"},
+	}, series["system_cpu_load"].Labels)
+	assert.Equal(t, []prompb.Sample{{Value: 0.75, Timestamp: timestampMs}}, series["system_cpu_load"].Samples)
+	assert.Equal(t, []prompb.Sample{{Value: 42, Timestamp: timestampMs}}, series["http_server_requests_total"].Samples)
+	assert.Equal(t, []prompb.Sample{{Value: 7, Timestamp: timestampMs}}, series["queue_size"].Samples)
+	assert.Equal(t, []prompb.Sample{{Value: 3, Timestamp: timestampMs}}, series["http_server_duration_count"].Samples)
+	assert.NotContains(t, series, "delta_requests_total")
+}
+
+func TestPushMetricsData(t *testing.T) {
+	var header http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		header = req.Header
+		var err error
+		body, err = io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Compression = "gzip"
+	cfg := &Config{
+		MetricsToken:    "metrics-token",
+		MetricsEndpoint: server.URL,
+		ClientConfig:    clientConfig,
+	}
+	require.NoError(t, cfg.Validate())
+
+	params := exportertest.NewNopSettings(metadata.Type)
+	exporter, err := createMetricsExporter(context.Background(), params, cfg)
+	require.NoError(t, err)
+	require.NoError(t, exporter.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, exporter.ConsumeMetrics(context.Background(), newTestMetrics()))
+	require.NoError(t, exporter.Shutdown(context.Background()))
+
+	assert.Equal(t, "Bearer metrics-token", header.Get("Authorization"))
+	assert.Equal(t, "application/x-protobuf", header.Get("Content-Type"))
+	assert.Equal(t, "snappy", header.Get("Content-Encoding"))
+	assert.Equal(t, "0.1.0", header.Get("X-Prometheus-Remote-Write-Version"))
+
+	decoded, err := snappy.Decode(nil, body)
+	require.NoError(t, err)
+	var request prompb.WriteRequest
+	require.NoError(t, request.Unmarshal(decoded))
+	expected, _ := toWriteRequest(newTestMetrics())
+	assert.Equal(t, seriesByName(expected), seriesByName(&request))
+}
+
+func TestPushMetricsDataTranslationFailures(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	core, observed := observer.New(zap.WarnLevel)
+	params := exportertest.NewNopSettings(metadata.Type)
+	params.Logger = zap.New(core)
+	cfg := &Config{
+		MetricsToken:    "metrics-token",
+		MetricsEndpoint: server.URL,
+		ClientConfig:    confighttp.NewDefaultClientConfig(),
+	}
+	exporter, err := newLogzioExporter(cfg, params)
+	require.NoError(t, err)
+	require.NoError(t, exporter.start(context.Background(), componenttest.NewNopHost()))
+
+	// The metrics that can't be translated are reported while the others are exported.
+	require.NoError(t, exporter.pushMetricsData(context.Background(), newTestMetrics()))
+	assert.Equal(t, int32(1), requests.Load())
+	entries := observed.FilterMessage("Failed to translate metrics, exporting remaining metrics").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "1", entries[0].ContextMap()["dropped"])
+
+	// Nothing is sent when no metric can be translated.
+	md := pmetric.NewMetrics()
+	delta := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	delta.SetName("delta.requests")
+	delta.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	delta.Sum().DataPoints().AppendEmpty().SetIntValue(1)
+	err = exporter.pushMetricsData(context.Background(), md)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.ErrorContains(t, err, "delta.requests")
+	assert.Equal(t, int32(1), requests.Load())
+
+	require.NoError(t, exporter.pushMetricsData(context.Background(), pmetric.NewMetrics()))
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestGetMetricsListenerURL(t *testing.T) {
+	assert.Equal(t, "https://listener.logz.io:8053", getMetricsListenerURL(""))
+	assert.Equal(t, "https://listener-eu.logz.io:8053", getMetricsListenerURL("EU"))
+
+	cfg := &Config{Region: "au"}
+	assert.Equal(t, "https://listener-au.logz.io:8053", cfg.metricsEndpoint())
+	cfg.MetricsEndpoint = "https://metrics.example.com"
+	assert.Equal(t, "https://metrics.example.com", cfg.metricsEndpoint())
+}
+
+// seriesByName indexes the time series of a remote write request by metric name
+func seriesByName(request *prompb.WriteRequest) map[string]prompb.TimeSeries {
+	series := make(map[string]prompb.TimeSeries, len(request.Timeseries))
+	for _, ts := range request.Timeseries {
+		for _, l := range ts.Labels {
+			if l.Name == "__name__" {
+				series[l.Value] = ts
+			}
+		}
+	}
+	return series
+}
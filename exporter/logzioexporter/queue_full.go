@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logzioexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter/internal/metadata"
+)
+
+// errBackpressure asks the upstream components to slow down and retry the batch. It is never permanent.
+var errBackpressure = fmt.Errorf("applying backpressure, retry later: %w", exporterhelper.ErrQueueIsFull)
+
+// queueFullHandler applies the configured `on_queue_full` policy to the batches rejected by a full sending queue,
+// counting each action.
+type queueFullHandler[T any] struct {
+	policy           string
+	next             func(context.Context, T) error
+	telemetryBuilder *metadata.TelemetryBuilder
+}
+
+func newQueueFullHandler[T any](policy string, next func(context.Context, T) error, telemetryBuilder *metadata.TelemetryBuilder) *queueFullHandler[T] {
+	if policy == "" {
+		policy = onQueueFullDrop
+	}
+	return &queueFullHandler[T]{
+		policy:           policy,
+		next:             next,
+		telemetryBuilder: telemetryBuilder,
+	}
+}
+
+// consume passes the batch to the exporter. With the `drop` policy the queue full error is returned upstream
+// as is, with the `block` policy it is returned as a retryable backpressure error.
+func (h *queueFullHandler[T]) consume(ctx context.Context, data T) error {
+	err := h.next(ctx, data)
+	if !errors.Is(err, exporterhelper.ErrQueueIsFull) {
+		return err
+	}
+	h.telemetryBuilder.LogzioexporterQueueFull.Add(ctx, 1, metric.WithAttributes(attribute.String("policy", h.policy)))
+	if h.policy == onQueueFullBlock {
+		return errBackpressure
+	}
+	return err
+}
+
+// queueFullTraces counts the trace batches rejected by a full sending queue.
+type queueFullTraces struct {
+	exporter.Traces
+	handler *queueFullHandler[ptrace.Traces]
+}
+
+func (e *queueFullTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return e.handler.consume(ctx, td)
+}
+
+// queueFullLogs counts the log batches rejected by a full sending queue.
+type queueFullLogs struct {
+	exporter.Logs
+	handler *queueFullHandler[plog.Logs]
+}
+
+func (e *queueFullLogs) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return e.handler.consume(ctx, ld)
+}
+
+// queueFullMetrics counts the metric batches rejected by a full sending queue.
+type queueFullMetrics struct {
+	exporter.Metrics
+	handler *queueFullHandler[pmetric.Metrics]
+}
+
+func (e *queueFullMetrics) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return e.handler.consume(ctx, md)
+}
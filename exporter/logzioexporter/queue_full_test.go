@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logzioexporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter/internal/metadatatest"
+)
+
+func TestQueueFullHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		expectedErr error
+	}{
+		{
+			name:        "default",
+			policy:      "",
+			expectedErr: exporterhelper.ErrQueueIsFull,
+		},
+		{
+			name:        "drop",
+			policy:      onQueueFullDrop,
+			expectedErr: exporterhelper.ErrQueueIsFull,
+		},
+		{
+			name:        "block",
+			policy:      onQueueFullBlock,
+			expectedErr: errBackpressure,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := componenttest.NewTelemetry()
+			defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+			tb, err := metadata.NewTelemetryBuilder(tel.NewTelemetrySettings())
+			require.NoError(t, err)
+			defer tb.Shutdown()
+
+			next := func(context.Context, string) error {
+				return exporterhelper.ErrQueueIsFull
+			}
+			h := newQueueFullHandler(tt.policy, next, tb)
+
+			// The returned error is retryable, so that the upstream components retry the batch.
+			err = h.consume(context.Background(), "batch")
+			assert.Equal(t, tt.expectedErr, err)
+			assert.False(t, consumererror.IsPermanent(err))
+
+			metadatatest.AssertEqualLogzioexporterQueueFull(t, tel, []metricdata.DataPoint[int64]{
+				{
+					Value:      1,
+					Attributes: attribute.NewSet(attribute.String("policy", h.policy)),
+				},
+			}, metricdatatest.IgnoreTimestamp())
+		})
+	}
+}
+
+func TestQueueFullHandlerPassesThroughOtherErrors(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+	tb, err := metadata.NewTelemetryBuilder(tel.NewTelemetrySettings())
+	require.NoError(t, err)
+	defer tb.Shutdown()
+
+	exportErr := errors.New("export failed")
+	next := func(context.Context, string) error {
+		return exportErr
+	}
+	h := newQueueFullHandler(onQueueFullDrop, next, tb)
+	assert.ErrorIs(t, h.consume(context.Background(), "batch"), exportErr)
+	_, err = tel.GetMetric("otelcol_logzioexporter_queue_full")
+	assert.Error(t, err, "no batch should be counted")
+}
+
+func TestQueueFullPolicyConfig(t *testing.T) {
+	cfg := &Config{Token: "token", QueueSettings: exporterhelper.NewDefaultQueueConfig()}
+	cfg.QueueSettings.BlockOnOverflow = true
+	assert.NoError(t, cfg.Validate())
+
+	cfg.OnQueueFull = onQueueFullBlock
+	assert.EqualError(t, cfg.Validate(), "`on_queue_full` can't be set with `sending_queue::block_on_overflow`, a blocking queue is never full")
+
+	cfg.OnQueueFull = "drop_oldest"
+	cfg.QueueSettings.BlockOnOverflow = false
+	assert.EqualError(t, cfg.Validate(), "`on_queue_full` must be either \"drop\" or \"block\", got \"drop_oldest\"")
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logzioexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter"
+
+import (
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// severityThresholds maps the supported `sampling::severity_threshold` values to the lowest severity
+// number of their level
+var severityThresholds = map[string]plog.SeverityNumber{
+	"debug": plog.SeverityNumberDebug,
+	"info":  plog.SeverityNumberInfo,
+	"warn":  plog.SeverityNumberWarn,
+	"error": plog.SeverityNumberError,
+	"fatal": plog.SeverityNumberFatal,
+}
+
+// logSampler decides which log records below the severity threshold are kept
+type logSampler struct {
+	threshold plog.SeverityNumber
+	keepRatio float64
+}
+
+// newLogSampler returns the sampler of the configuration, or nil when sampling is disabled
+func newLogSampler(cfg SamplingConfig) *logSampler {
+	if cfg.SeverityThreshold == "" {
+		return nil
+	}
+	return &logSampler{
+		threshold: severityThresholds[strings.ToLower(cfg.SeverityThreshold)],
+		keepRatio: cfg.KeepRatio,
+	}
+}
+
+// keep reports whether a log record is kept. Records without a severity number and records at or above the
+// threshold are always kept. The decision is derived from the trace ID when present, so that the records of
+// a trace are kept or dropped together, and is random otherwise.
+func (s *logSampler) keep(log plog.LogRecord) bool {
+	if s == nil || log.SeverityNumber() == plog.SeverityNumberUnspecified || log.SeverityNumber() >= s.threshold {
+		return true
+	}
+	if s.keepRatio >= 1 {
+		return true
+	}
+	traceID := log.TraceID()
+	if traceID.IsEmpty() {
+		return rand.Float64() < s.keepRatio
+	}
+	hash := fnv.New64a()
+	_, _ = hash.Write(traceID[:])
+	return float64(hash.Sum64())/math.MaxUint64 < s.keepRatio
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logzioexporter
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter/internal/metadatatest"
+)
+
+func randomTraceID(r *rand.Rand) pcommon.TraceID {
+	var traceID pcommon.TraceID
+	binary.BigEndian.PutUint64(traceID[:8], r.Uint64())
+	binary.BigEndian.PutUint64(traceID[8:], r.Uint64())
+	return traceID
+}
+
+func TestLogSamplerKeptFraction(t *testing.T) {
+	const records = 100000
+	tests := []struct {
+		name      string
+		withTrace bool
+		keepRatio float64
+	}{
+		{name: "random", keepRatio: 0.1},
+		{name: "trace id", withTrace: true, keepRatio: 0.1},
+		{name: "trace id half", withTrace: true, keepRatio: 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sampler := newLogSampler(SamplingConfig{SeverityThreshold: "WARN", KeepRatio: tt.keepRatio})
+			r := rand.New(rand.NewPCG(1, 2))
+			log := plog.NewLogRecord()
+			log.SetSeverityNumber(plog.SeverityNumberDebug)
+			kept := 0
+			for i := 0; i < records; i++ {
+				if tt.withTrace {
+					log.SetTraceID(randomTraceID(r))
+				}
+				if sampler.keep(log) {
+					kept++
+				}
+			}
+			assert.InDelta(t, tt.keepRatio, float64(kept)/records, 0.01)
+		})
+	}
+}
+
+func TestLogSamplerDeterministicPerTrace(t *testing.T) {
+	sampler := newLogSampler(SamplingConfig{SeverityThreshold: "warn", KeepRatio: 0.5})
+	r := rand.New(rand.NewPCG(3, 4))
+	for i := 0; i < 1000; i++ {
+		traceID := randomTraceID(r)
+		debug := plog.NewLogRecord()
+		debug.SetSeverityNumber(plog.SeverityNumberDebug)
+		debug.SetTraceID(traceID)
+		info := plog.NewLogRecord()
+		info.SetSeverityNumber(plog.SeverityNumberInfo2)
+		info.SetTraceID(traceID)
+		assert.Equal(t, sampler.keep(debug), sampler.keep(info))
+	}
+}
+
+func TestLogSamplerAlwaysKept(t *testing.T) {
+	sampler := newLogSampler(SamplingConfig{SeverityThreshold: "warn", KeepRatio: 0})
+	log := plog.NewLogRecord()
+	assert.True(t, sampler.keep(log), "records without severity are kept")
+	log.SetSeverityNumber(plog.SeverityNumberWarn)
+	assert.True(t, sampler.keep(log))
+	log.SetSeverityNumber(plog.SeverityNumberError)
+	assert.True(t, sampler.keep(log))
+	log.SetSeverityNumber(plog.SeverityNumberInfo4)
+	assert.False(t, sampler.keep(log))
+
+	var disabled *logSampler
+	assert.Nil(t, newLogSampler(SamplingConfig{KeepRatio: 0.5}))
+	assert.True(t, disabled.keep(log))
+
+	sampler = newLogSampler(SamplingConfig{SeverityThreshold: "warn", KeepRatio: 1})
+	log.SetTraceID(pcommon.TraceID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	assert.True(t, sampler.keep(log))
+}
+
+func TestPushLogsDataSampling(t *testing.T) {
+	var recordedRequests []byte
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		recordedRequests, _ = io.ReadAll(req.Body)
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tel := componenttest.NewTelemetry()
+	defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = server.URL
+	cfg := &Config{
+		Token:        "token",
+		ClientConfig: clientConfig,
+		Sampling: SamplingConfig{
+			SeverityThreshold: "info",
+			KeepRatio:         0,
+		},
+	}
+	ld := plog.NewLogs()
+	logs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, severity := range []plog.SeverityNumber{plog.SeverityNumberDebug, plog.SeverityNumberTrace, plog.SeverityNumberInfo, plog.SeverityNumberError} {
+		log := logs.AppendEmpty()
+		log.SetSeverityNumber(severity)
+		log.Body().SetStr(severity.String())
+	}
+
+	exporter, err := newLogzioLogsExporter(cfg, metadatatest.NewSettings(tel))
+	require.NoError(t, err)
+	require.NoError(t, exporter.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, exporter.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, exporter.Shutdown(context.Background()))
+
+	requests := strings.Split(strings.TrimSuffix(string(recordedRequests), "\n"), "\n")
+	require.Len(t, requests, 2)
+	assert.Contains(t, requests[0], `"message":"Info"`)
+	assert.Contains(t, requests[1], `"message":"Error"`)
+	metadatatest.AssertEqualLogzioexporterRecordsSampled(t, tel,
+		[]metricdata.DataPoint[int64]{{Value: 2}},
+		metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpforwarderextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpforwarderextension"
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	concurrencyLimitReject = "reject"
+	concurrencyLimitQueue  = "queue"
+
+	defaultConcurrencyQueueTimeout = time.Second
+)
+
+// forwardLimiter limits the number of requests forwarded to the egress backend at the same time.
+// A nil *forwardLimiter is valid and never limits.
+type forwardLimiter struct {
+	slots chan struct{}
+	// queueTimeout is how long a request waits for a slot, requests are rejected right away when it is 0.
+	queueTimeout time.Duration
+}
+
+func newForwardLimiter(cfg *ConcurrencyLimitConfig) *forwardLimiter {
+	l := &forwardLimiter{
+		slots: make(chan struct{}, cfg.MaxConcurrentForwards),
+	}
+	if cfg.OnLimit == concurrencyLimitQueue {
+		l.queueTimeout = cfg.QueueTimeout
+		if l.queueTimeout == 0 {
+			l.queueTimeout = defaultConcurrencyQueueTimeout
+		}
+	}
+	return l
+}
+
+// acquire takes a slot, waiting for one to be released if queueing is enabled. It reports whether
+// a slot was taken, in which case release must be called once the request is done.
+func (l *forwardLimiter) acquire(ctx context.Context) bool {
+	if l == nil {
+		return true
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+	}
+	if l.queueTimeout == 0 {
+		return false
+	}
+
+	timer := time.NewTimer(l.queueTimeout)
+	defer timer.Stop()
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// release frees a slot taken by acquire.
+func (l *forwardLimiter) release() {
+	if l == nil {
+		return
+	}
+	<-l.slots
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpforwarderextension
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwardLimiterReject(t *testing.T) {
+	l := newForwardLimiter(&ConcurrencyLimitConfig{MaxConcurrentForwards: 2})
+	ctx := context.Background()
+
+	assert.True(t, l.acquire(ctx))
+	assert.True(t, l.acquire(ctx))
+	assert.False(t, l.acquire(ctx))
+
+	l.release()
+	assert.True(t, l.acquire(ctx))
+}
+
+func TestForwardLimiterQueue(t *testing.T) {
+	l := newForwardLimiter(&ConcurrencyLimitConfig{
+		MaxConcurrentForwards: 1,
+		OnLimit:               concurrencyLimitQueue,
+		QueueTimeout:          20 * time.Millisecond,
+	})
+	ctx := context.Background()
+	assert.True(t, l.acquire(ctx))
+
+	// Times out while the slot is taken.
+	assert.False(t, l.acquire(ctx))
+
+	// Gets the slot once it is released.
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		l.release()
+	}()
+	assert.True(t, l.acquire(ctx))
+
+	// Gives up when the request is canceled.
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.False(t, l.acquire(canceled))
+}
+
+func TestForwardLimiterDefaults(t *testing.T) {
+	assert.Equal(t, time.Duration(0), newForwardLimiter(&ConcurrencyLimitConfig{MaxConcurrentForwards: 1}).queueTimeout)
+	assert.Equal(t, defaultConcurrencyQueueTimeout, newForwardLimiter(&ConcurrencyLimitConfig{
+		MaxConcurrentForwards: 1,
+		OnLimit:               concurrencyLimitQueue,
+	}).queueTimeout)
+
+	// A nil limiter never limits.
+	var l *forwardLimiter
+	assert.True(t, l.acquire(context.Background()))
+	l.release()
+}
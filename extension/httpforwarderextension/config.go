@@ -4,6 +4,10 @@
 package httpforwarderextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpforwarderextension"
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 )
 
@@ -14,4 +18,138 @@ type Config struct {
 
 	// Egress holds config settings to use for forwarded requests.
 	Egress confighttp.ClientConfig `mapstructure:"egress"`
+
+	// ShadowEgress holds config settings for an optional shadow backend. When set,
+	// every request is also sent asynchronously to this backend and its response
+	// is discarded, so that it never affects the response sent to the client.
+	ShadowEgress *confighttp.ClientConfig `mapstructure:"shadow_egress"`
+
+	// InternalMetrics holds config settings for an optional HTTP server exposing
+	// the forwarder's own metrics in Prometheus format on `/metrics`.
+	// The endpoint is disabled when this is not set.
+	InternalMetrics *confighttp.ServerConfig `mapstructure:"internal_metrics"`
+
+	// FallbackResponse holds an optional canned response sent to the client when
+	// the egress backend cannot be reached. Responses from the backend, including
+	// 5xx ones, are always passed through unchanged.
+	FallbackResponse *FallbackResponseConfig `mapstructure:"fallback_response"`
+
+	// Idempotency holds config settings for optional replay protection. When set,
+	// a request carrying an idempotency key already seen within the window is not
+	// forwarded again, the client gets the cached response instead, or a 409 while
+	// the first request is still in flight.
+	Idempotency *IdempotencyConfig `mapstructure:"idempotency"`
+
+	// DeadlineHeader holds config settings for optionally telling the egress backend
+	// when the forwarder stops waiting for its response, so that it can abort work early.
+	DeadlineHeader *DeadlineHeaderConfig `mapstructure:"deadline_header"`
+
+	// ConcurrencyLimit holds config settings for optionally limiting the number of requests
+	// forwarded to the egress backend at the same time. There is no limit when this is not set.
+	ConcurrencyLimit *ConcurrencyLimitConfig `mapstructure:"concurrency_limit"`
+
+	// PreserveHTTPSemantics makes the forwarder keep the `Expect: 100-continue` semantics of
+	// requests and copy response trailers back to the client. Requests expecting a 100-continue
+	// are then not buffered for the shadow backend anymore, they are mirrored once the egress
+	// backend has read their whole body, and not at all when it rejected them early.
+	PreserveHTTPSemantics bool `mapstructure:"preserve_http_semantics"`
+}
+
+// ConcurrencyLimitConfig defines the limit of requests forwarded to the egress backend at the same time.
+type ConcurrencyLimitConfig struct {
+	// MaxConcurrentForwards is the maximum number of requests forwarded at the same time.
+	MaxConcurrentForwards int `mapstructure:"max_concurrent_forwards"`
+
+	// OnLimit is the policy applied to requests over the limit, either `reject` to respond
+	// with a 503 right away, or `queue` to wait for a request to complete first. Defaults to `reject`.
+	OnLimit string `mapstructure:"on_limit"`
+
+	// QueueTimeout is how long a queued request waits before being rejected with a 503.
+	// Only used with the `queue` policy. Defaults to 1s.
+	QueueTimeout time.Duration `mapstructure:"queue_timeout"`
+}
+
+// DeadlineHeaderConfig defines the header propagating the request deadline to the egress backend.
+type DeadlineHeaderConfig struct {
+	// Name is the name of the request header. Defaults to `X-Request-Deadline`.
+	Name string `mapstructure:"name"`
+
+	// Format is the format of the header value, either `timeout_ms` for the number of
+	// milliseconds left, or `rfc3339` for the absolute deadline. Defaults to `timeout_ms`.
+	Format string `mapstructure:"format"`
+}
+
+// IdempotencyConfig defines replay protection based on an idempotency key header.
+type IdempotencyConfig struct {
+	// Header is the name of the request header holding the idempotency key.
+	// Defaults to `Idempotency-Key`.
+	Header string `mapstructure:"header"`
+
+	// Window is how long a key is remembered after the first request with it
+	// was received. Defaults to 5m.
+	Window time.Duration `mapstructure:"window"`
+
+	// CacheSize is the maximum number of keys remembered, the least recently
+	// used keys are evicted first. Defaults to 10000.
+	CacheSize int `mapstructure:"cache_size"`
+}
+
+// FallbackResponseConfig defines the response sent when the egress backend is down.
+type FallbackResponseConfig struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `mapstructure:"status_code"`
+
+	// Body is the response body.
+	Body string `mapstructure:"body"`
+
+	// ContentType is the value of the Content-Type header. Defaults to
+	// `text/plain; charset=utf-8` when not set.
+	ContentType string `mapstructure:"content_type"`
+}
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.ShadowEgress != nil && cfg.ShadowEgress.Endpoint == "" {
+		return errors.New("'shadow_egress.endpoint' config option cannot be empty")
+	}
+	if cfg.FallbackResponse != nil && (cfg.FallbackResponse.StatusCode < 100 || cfg.FallbackResponse.StatusCode > 599) {
+		return errors.New("'fallback_response.status_code' must be a valid HTTP status code")
+	}
+	if cfg.Idempotency != nil {
+		if cfg.Idempotency.Window < 0 {
+			return errors.New("'idempotency.window' must not be negative")
+		}
+		if cfg.Idempotency.CacheSize < 0 {
+			return errors.New("'idempotency.cache_size' must not be negative")
+		}
+	}
+	if cfg.DeadlineHeader != nil {
+		switch cfg.DeadlineHeader.Format {
+		case "", deadlineFormatTimeoutMillis, deadlineFormatRFC3339:
+		default:
+			return fmt.Errorf("'deadline_header.format' must be one of %q or %q", deadlineFormatTimeoutMillis, deadlineFormatRFC3339)
+		}
+	}
+	if cfg.ConcurrencyLimit != nil {
+		if cfg.ConcurrencyLimit.MaxConcurrentForwards <= 0 {
+			return errors.New("'concurrency_limit.max_concurrent_forwards' must be positive")
+		}
+		switch cfg.ConcurrencyLimit.OnLimit {
+		case "", concurrencyLimitReject, concurrencyLimitQueue:
+		default:
+			return fmt.Errorf("'concurrency_limit.on_limit' must be one of %q or %q", concurrencyLimitReject, concurrencyLimitQueue)
+		}
+		if cfg.ConcurrencyLimit.QueueTimeout < 0 {
+			return errors.New("'concurrency_limit.queue_timeout' must not be negative")
+		}
+	}
+	if cfg.InternalMetrics != nil {
+		if cfg.InternalMetrics.Endpoint == "" {
+			return errors.New("'internal_metrics.endpoint' config option cannot be empty")
+		}
+		if cfg.InternalMetrics.Endpoint == cfg.Ingress.Endpoint {
+			return errors.New("'internal_metrics.endpoint' must be different from 'ingress.endpoint'")
+		}
+	}
+	return nil
 }
@@ -4,6 +4,7 @@
 package httpforwarderextension
 
 import (
+	"net/http"
 	"path/filepath"
 	"testing"
 	"time"
@@ -50,6 +51,18 @@ func TestLoadConfig(t *testing.T) {
 				Egress: egressCfg,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "2"),
+			expected: func() component.Config {
+				cfg := NewFactory().CreateDefaultConfig().(*Config)
+				cfg.Ingress.Endpoint = "http://localhost:7070"
+				cfg.Egress.Endpoint = "http://target/"
+				cfg.InternalMetrics = &confighttp.ServerConfig{
+					Endpoint: "localhost:8888",
+				}
+				return cfg
+			}(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -65,3 +78,136 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr string
+	}{
+		{
+			name: "internal metrics disabled",
+			config: &Config{
+				Ingress: confighttp.ServerConfig{Endpoint: "localhost:7070"},
+			},
+		},
+		{
+			name: "internal metrics on separate endpoint",
+			config: &Config{
+				Ingress:         confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				InternalMetrics: &confighttp.ServerConfig{Endpoint: "localhost:8888"},
+			},
+		},
+		{
+			name: "internal metrics without endpoint",
+			config: &Config{
+				Ingress:         confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				InternalMetrics: &confighttp.ServerConfig{},
+			},
+			wantErr: "'internal_metrics.endpoint' config option cannot be empty",
+		},
+		{
+			name: "internal metrics on ingress endpoint",
+			config: &Config{
+				Ingress:         confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				InternalMetrics: &confighttp.ServerConfig{Endpoint: "localhost:7070"},
+			},
+			wantErr: "'internal_metrics.endpoint' must be different from 'ingress.endpoint'",
+		},
+		{
+			name: "shadow egress without endpoint",
+			config: &Config{
+				Ingress:      confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				ShadowEgress: &confighttp.ClientConfig{},
+			},
+			wantErr: "'shadow_egress.endpoint' config option cannot be empty",
+		},
+		{
+			name: "fallback response with valid status code",
+			config: &Config{
+				Ingress:          confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				FallbackResponse: &FallbackResponseConfig{StatusCode: http.StatusServiceUnavailable},
+			},
+		},
+		{
+			name: "fallback response with invalid status code",
+			config: &Config{
+				Ingress:          confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				FallbackResponse: &FallbackResponseConfig{},
+			},
+			wantErr: "'fallback_response.status_code' must be a valid HTTP status code",
+		},
+		{
+			name: "idempotency with negative window",
+			config: &Config{
+				Ingress:     confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				Idempotency: &IdempotencyConfig{Window: -time.Second},
+			},
+			wantErr: "'idempotency.window' must not be negative",
+		},
+		{
+			name: "idempotency with negative cache size",
+			config: &Config{
+				Ingress:     confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				Idempotency: &IdempotencyConfig{CacheSize: -1},
+			},
+			wantErr: "'idempotency.cache_size' must not be negative",
+		},
+		{
+			name: "deadline header with valid format",
+			config: &Config{
+				Ingress:        confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				DeadlineHeader: &DeadlineHeaderConfig{Format: "rfc3339"},
+			},
+		},
+		{
+			name: "deadline header with invalid format",
+			config: &Config{
+				Ingress:        confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				DeadlineHeader: &DeadlineHeaderConfig{Format: "seconds"},
+			},
+			wantErr: `'deadline_header.format' must be one of "timeout_ms" or "rfc3339"`,
+		},
+		{
+			name: "concurrency limit with queue policy",
+			config: &Config{
+				Ingress:          confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				ConcurrencyLimit: &ConcurrencyLimitConfig{MaxConcurrentForwards: 10, OnLimit: "queue", QueueTimeout: time.Second},
+			},
+		},
+		{
+			name: "concurrency limit without limit",
+			config: &Config{
+				Ingress:          confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				ConcurrencyLimit: &ConcurrencyLimitConfig{},
+			},
+			wantErr: "'concurrency_limit.max_concurrent_forwards' must be positive",
+		},
+		{
+			name: "concurrency limit with invalid policy",
+			config: &Config{
+				Ingress:          confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				ConcurrencyLimit: &ConcurrencyLimitConfig{MaxConcurrentForwards: 10, OnLimit: "drop"},
+			},
+			wantErr: `'concurrency_limit.on_limit' must be one of "reject" or "queue"`,
+		},
+		{
+			name: "concurrency limit with negative queue timeout",
+			config: &Config{
+				Ingress:          confighttp.ServerConfig{Endpoint: "localhost:7070"},
+				ConcurrencyLimit: &ConcurrencyLimitConfig{MaxConcurrentForwards: 10, QueueTimeout: -time.Second},
+			},
+			wantErr: "'concurrency_limit.queue_timeout' must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -4,13 +4,18 @@
 package httpforwarderextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpforwarderextension"
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componentstatus"
@@ -18,13 +23,33 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	defaultDeadlineHeader = "X-Request-Deadline"
+
+	deadlineFormatTimeoutMillis = "timeout_ms"
+	deadlineFormatRFC3339       = "rfc3339"
+)
+
 type httpForwarder struct {
-	forwardTo  *url.URL
-	httpClient *http.Client
-	server     *http.Server
-	settings   component.TelemetrySettings
-	config     *Config
-	shutdownWG sync.WaitGroup
+	forwardTo     *url.URL
+	httpClient    *http.Client
+	shadowTo      *url.URL
+	shadowClient  *http.Client
+	server        *http.Server
+	metricsServer *http.Server
+	metrics       *forwarderMetrics
+	idempotency   *idempotencyCache
+	limiter       *forwardLimiter
+	settings      component.TelemetrySettings
+	config        *Config
+	shutdownWG    sync.WaitGroup
+
+	// shadowCtx bounds the shadow requests, it is cancelled on shutdown. shadowMu guards
+	// shadowClosed so that no shadow request is started once the shutdown began.
+	shadowCtx    context.Context
+	cancelShadow context.CancelFunc
+	shadowMu     sync.Mutex
+	shadowClosed bool
 }
 
 var _ extension.Extension = (*httpForwarder)(nil)
@@ -41,6 +66,13 @@ func (h *httpForwarder) Start(ctx context.Context, host component.Host) error {
 	}
 	h.httpClient = httpClient
 
+	if h.config.ShadowEgress != nil {
+		h.shadowClient, err = h.config.ShadowEgress.ToClient(ctx, host, h.settings)
+		if err != nil {
+			return fmt.Errorf("failed to create shadow HTTP Client: %w", err)
+		}
+	}
+
 	handler := http.NewServeMux()
 	handler.HandleFunc("/", h.forwardRequest)
 
@@ -49,27 +81,133 @@ func (h *httpForwarder) Start(ctx context.Context, host component.Host) error {
 		return fmt.Errorf("failed to create HTTP Client: %w", err)
 	}
 
+	h.serve(host, h.server, listener)
+
+	if h.config.InternalMetrics != nil {
+		if err = h.startMetricsServer(ctx, host); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startMetricsServer starts the server exposing the forwarder's own metrics.
+// It uses a dedicated listener so that scrapes are never forwarded.
+func (h *httpForwarder) startMetricsServer(ctx context.Context, host component.Host) error {
+	listener, err := h.config.InternalMetrics.ToListener(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", h.config.InternalMetrics.Endpoint, err)
+	}
+
+	handler := http.NewServeMux()
+	handler.Handle("/metrics", h.metrics.handler())
+
+	h.metricsServer, err = h.config.InternalMetrics.ToServer(ctx, host, h.settings, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create internal metrics server: %w", err)
+	}
+
+	h.serve(host, h.metricsServer, listener)
+	return nil
+}
+
+func (h *httpForwarder) serve(host component.Host, server *http.Server, listener net.Listener) {
 	h.shutdownWG.Add(1)
 	go func() {
 		defer h.shutdownWG.Done()
-		if errHTTP := h.server.Serve(listener); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+		if errHTTP := server.Serve(listener); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
 			componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(errHTTP))
 		}
 	}()
-
-	return nil
 }
 
-func (h *httpForwarder) Shutdown(_ context.Context) error {
-	if h.server == nil {
-		return nil
+func (h *httpForwarder) Shutdown(ctx context.Context) error {
+	h.shadowMu.Lock()
+	h.shadowClosed = true
+	h.shadowMu.Unlock()
+	// In-flight shadow requests are cancelled once the shutdown context is done.
+	stop := context.AfterFunc(ctx, h.cancelShadow)
+	defer stop()
+	defer h.cancelShadow()
+
+	var err error
+	if h.server != nil {
+		err = h.server.Close()
+	}
+	if h.metricsServer != nil {
+		err = errors.Join(err, h.metricsServer.Close())
 	}
-	err := h.server.Close()
 	h.shutdownWG.Wait()
 	return err
 }
 
+// shadowRequest sends a copy of the request to the shadow backend and discards its response.
+// Failures are only logged and counted, they never affect the client.
+func (h *httpForwarder) shadowRequest(request *http.Request, body []byte) {
+	shadowRequest := request.Clone(h.shadowCtx)
+	shadowRequest.URL.Host = h.shadowTo.Host
+	shadowRequest.URL.Scheme = h.shadowTo.Scheme
+	shadowRequest.Host = h.shadowTo.Host
+	shadowRequest.RequestURI = ""
+	shadowRequest.Body = io.NopCloser(bytes.NewReader(body))
+	shadowRequest.ContentLength = int64(len(body))
+
+	for k, v := range h.config.ShadowEgress.Headers {
+		shadowRequest.Header.Add(k, string(v))
+	}
+	addViaHeader(shadowRequest.Header, request.Proto, request.Host)
+
+	h.shadowMu.Lock()
+	defer h.shadowMu.Unlock()
+	if h.shadowClosed {
+		return
+	}
+	h.shutdownWG.Add(1)
+	go func() {
+		defer h.shutdownWG.Done()
+		response, err := h.shadowClient.Do(shadowRequest)
+		h.metrics.recordShadowRequest(err)
+		if err != nil {
+			h.settings.Logger.Warn("Error mirroring request to shadow backend", zap.Error(err))
+			return
+		}
+		_, _ = io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+	}()
+}
+
 func (h *httpForwarder) forwardRequest(writer http.ResponseWriter, request *http.Request) {
+	start := time.Now()
+	recorder := &statusRecorder{ResponseWriter: writer, statusCode: http.StatusOK}
+	defer func() {
+		h.metrics.recordRequest(request.Method, recorder.statusCode, time.Since(start))
+	}()
+	writer = recorder
+
+	idempotencyKey := h.idempotency.key(request)
+	if cached, duplicate := h.idempotency.begin(idempotencyKey, start); duplicate {
+		if cached == nil {
+			http.Error(writer, "a request with the same idempotency key is in progress", http.StatusConflict)
+			return
+		}
+		cached.replay(writer)
+		return
+	}
+	// Unless a complete response was stored, let the client retry the request with the same key.
+	completed := false
+	defer func() {
+		if !completed {
+			h.idempotency.forget(idempotencyKey)
+		}
+	}()
+
+	if !h.limiter.acquire(request.Context()) {
+		http.Error(writer, "too many requests are being forwarded", http.StatusServiceUnavailable)
+		return
+	}
+	defer h.limiter.release()
+
 	forwarderRequest := request.Clone(request.Context())
 	forwarderRequest.URL.Host = h.forwardTo.Host
 	forwarderRequest.URL.Scheme = h.forwardTo.Scheme
@@ -77,6 +215,29 @@ func (h *httpForwarder) forwardRequest(writer http.ResponseWriter, request *http
 	// Clear RequestURI to avoid getting "http: Request.RequestURI can't be set in client requests" error.
 	forwarderRequest.RequestURI = ""
 
+	// Buffer the body so that it can be sent to both the primary and the shadow backends.
+	// Reading the body upfront acknowledges an Expect: 100-continue on behalf of the primary
+	// backend, so when preserving it the body is instead recorded while the primary reads it.
+	if h.shadowClient != nil {
+		if h.config.PreserveHTTPSemantics && expectsContinue(request) {
+			recorded := &recordingBody{ReadCloser: request.Body}
+			forwarderRequest.Body = recorded
+			defer func() {
+				if body, complete := recorded.recorded(); complete {
+					h.shadowRequest(request, body)
+				}
+			}()
+		} else {
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				http.Error(writer, err.Error(), http.StatusBadRequest)
+				return
+			}
+			forwarderRequest.Body = io.NopCloser(bytes.NewReader(body))
+			h.shadowRequest(request, body)
+		}
+	}
+
 	// Add additional headers.
 	for k, v := range h.config.Egress.Headers {
 		forwarderRequest.Header.Add(k, string(v))
@@ -86,8 +247,17 @@ func (h *httpForwarder) forwardRequest(writer http.ResponseWriter, request *http
 	// See https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Via.
 	addViaHeader(forwarderRequest.Header, request.Proto, request.Host)
 
+	// The egress timeout only starts with the egress request, not when the request was received.
+	if h.config.DeadlineHeader != nil {
+		h.setDeadlineHeader(forwarderRequest, time.Now())
+	}
+
 	response, err := h.httpClient.Do(forwarderRequest)
 	if err != nil {
+		if h.config.FallbackResponse != nil && isUnreachable(err) {
+			h.writeFallbackResponse(writer)
+			return
+		}
 		http.Error(writer, err.Error(), http.StatusBadGateway)
 	}
 
@@ -101,18 +271,134 @@ func (h *httpForwarder) forwardRequest(writer http.ResponseWriter, request *http
 		writer.Header().Set(k, response.Header.Get(k))
 	}
 	addViaHeader(writer.Header(), response.Proto, request.Host)
+	if h.config.PreserveHTTPSemantics {
+		// Announce the trailers declared by the backend, so that the response is chunked.
+		for k := range response.Trailer {
+			writer.Header().Add("Trailer", k)
+		}
+	}
 
 	writer.WriteHeader(response.StatusCode)
-	written, err := io.Copy(writer, response.Body)
+	var body io.Writer = writer
+	var captured bytes.Buffer
+	if idempotencyKey != "" {
+		body = io.MultiWriter(writer, &captured)
+	}
+	written, err := io.Copy(body, response.Body)
 	if err != nil {
 		h.settings.Logger.Warn("Error writing HTTP response message", zap.Error(err))
 	}
+	if h.config.PreserveHTTPSemantics {
+		// Trailer values are only known once the body has been read.
+		for k, v := range response.Trailer {
+			writer.Header()[http.TrailerPrefix+k] = v
+		}
+	}
+	// A partially copied body is never replayed, the client would get a truncated response.
+	if err == nil && (response.ContentLength == -1 || written == response.ContentLength) {
+		h.idempotency.complete(idempotencyKey, &cachedResponse{
+			statusCode: response.StatusCode,
+			header:     writer.Header().Clone(),
+			body:       captured.Bytes(),
+		})
+		completed = true
+	}
 
 	if response.ContentLength != written {
 		h.settings.Logger.Warn("Response from target not fully copied, body might be corrupted")
 	}
 }
 
+// setDeadlineHeader sets the configured deadline header on the egress request. The deadline is
+// the earliest of the ingress request deadline and the egress timeout, the header is not set
+// when there is neither.
+func (h *httpForwarder) setDeadlineHeader(request *http.Request, now time.Time) {
+	deadline, ok := request.Context().Deadline()
+	if timeout := h.config.Egress.Timeout; timeout > 0 {
+		if egressDeadline := now.Add(timeout); !ok || egressDeadline.Before(deadline) {
+			deadline, ok = egressDeadline, true
+		}
+	}
+	if !ok {
+		return
+	}
+
+	name := h.config.DeadlineHeader.Name
+	if name == "" {
+		name = defaultDeadlineHeader
+	}
+	switch h.config.DeadlineHeader.Format {
+	case deadlineFormatRFC3339:
+		request.Header.Set(name, deadline.UTC().Format(time.RFC3339Nano))
+	default:
+		request.Header.Set(name, strconv.FormatInt(max(deadline.Sub(now).Milliseconds(), 0), 10))
+	}
+}
+
+// writeFallbackResponse sends the configured canned response to the client.
+func (h *httpForwarder) writeFallbackResponse(writer http.ResponseWriter) {
+	fallback := h.config.FallbackResponse
+	contentType := fallback.ContentType
+	if contentType == "" {
+		contentType = "text/plain; charset=utf-8"
+	}
+	writer.Header().Set("Content-Type", contentType)
+	writer.WriteHeader(fallback.StatusCode)
+	if _, err := io.WriteString(writer, fallback.Body); err != nil {
+		h.settings.Logger.Warn("Error writing fallback response", zap.Error(err))
+	}
+}
+
+// isUnreachable reports whether the error means no connection to the backend could be made.
+func isUnreachable(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// statusRecorder wraps an http.ResponseWriter to keep track of the status code sent to the client.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// expectsContinue reports whether the client waits for a 100 Continue before sending the request body.
+func expectsContinue(request *http.Request) bool {
+	return strings.EqualFold(request.Header.Get("Expect"), "100-continue")
+}
+
+// recordingBody records a request body while it is read. It may be read by the transport
+// after the response was received, so the recording is guarded by a mutex.
+type recordingBody struct {
+	io.ReadCloser
+
+	mu       sync.Mutex
+	buf      bytes.Buffer
+	complete bool
+}
+
+func (b *recordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		b.complete = true
+	}
+	return n, err
+}
+
+// recorded returns the body read so far, and whether it was read to the end.
+func (b *recordingBody) recorded() ([]byte, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return bytes.Clone(b.buf.Bytes()), b.complete
+}
+
 func addViaHeader(header http.Header, protocol string, host string) {
 	header.Add("Via", fmt.Sprintf("%s %s", protocol, host))
 }
@@ -122,6 +408,15 @@ func newHTTPForwarder(config *Config, settings component.TelemetrySettings) (ext
 		return nil, errors.New("'egress.endpoint' config option cannot be empty")
 	}
 
+	var shadowTo *url.URL
+	if config.ShadowEgress != nil {
+		var err error
+		shadowTo, err = url.Parse(config.ShadowEgress.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("enter a valid URL for 'shadow_egress.endpoint': %w", err)
+		}
+	}
+
 	url, err := url.Parse(config.Egress.Endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("enter a valid URL for 'egress.endpoint': %w", err)
@@ -130,8 +425,19 @@ func newHTTPForwarder(config *Config, settings component.TelemetrySettings) (ext
 	h := &httpForwarder{
 		config:    config,
 		forwardTo: url,
+		shadowTo:  shadowTo,
 		settings:  settings,
 	}
+	h.shadowCtx, h.cancelShadow = context.WithCancel(context.Background())
+	if config.InternalMetrics != nil {
+		h.metrics = newForwarderMetrics()
+	}
+	if config.Idempotency != nil {
+		h.idempotency = newIdempotencyCache(config.Idempotency)
+	}
+	if config.ConcurrencyLimit != nil {
+		h.limiter = newForwardLimiter(config.ConcurrencyLimit)
+	}
 
 	return h, nil
 }
@@ -5,13 +5,19 @@ package httpforwarderextension
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -249,6 +255,644 @@ func TestExtension(t *testing.T) {
 	}
 }
 
+func TestInternalMetrics(t *testing.T) {
+	listenAt := testutil.GetAvailableLocalAddress(t)
+	metricsAt := testutil.GetAvailableLocalAddress(t)
+
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/metrics" {
+			t.Error("metrics endpoint request must not be forwarded")
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer backend.Close()
+
+	cfg := &Config{
+		Ingress: confighttp.ServerConfig{
+			Endpoint: listenAt,
+		},
+		Egress: confighttp.ClientConfig{
+			Endpoint: backend.URL,
+		},
+		InternalMetrics: &confighttp.ServerConfig{
+			Endpoint: metricsAt,
+		},
+	}
+	hf, err := newHTTPForwarder(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, hf.Start(ctx, componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, hf.Shutdown(ctx))
+	}()
+
+	httpClient := http.Client{}
+	response, err := httpClient.Post(fmt.Sprintf("http://%s/api/dosomething", listenAt), "text/plain", strings.NewReader("body"))
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	require.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	response, err = httpClient.Get(fmt.Sprintf("http://%s/metrics", metricsAt))
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	body := string(readBody(response.Body))
+	assert.Contains(t, body, `httpforwarder_requests_total{method="POST",status_code="202"} 1`)
+	assert.Contains(t, body, `httpforwarder_request_duration_seconds_count{method="POST"} 1`)
+}
+
+func TestShadowEgress(t *testing.T) {
+	tests := []struct {
+		name               string
+		shadowUnreachable  bool
+		expectedShadowBody string
+		expectedMetric     string
+	}{
+		{
+			name:               "Shadow backend receives a copy",
+			expectedShadowBody: "client_body",
+			expectedMetric:     `httpforwarder_shadow_requests_total{result="success"} 1`,
+		},
+		{
+			name:              "Shadow backend unreachable",
+			shadowUnreachable: true,
+			expectedMetric:    `httpforwarder_shadow_requests_total{result="failure"} 1`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			listenAt := testutil.GetAvailableLocalAddress(t)
+			metricsAt := testutil.GetAvailableLocalAddress(t)
+
+			backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "client_body", string(readBody(r.Body)))
+				w.WriteHeader(http.StatusAccepted)
+				_, err := w.Write([]byte("primary"))
+				assert.NoError(t, err)
+			}))
+			defer backend.Close()
+
+			shadowReceived := make(chan *http.Request, 1)
+			shadowBody := make(chan string, 1)
+			shadow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				shadowBody <- string(readBody(r.Body))
+				shadowReceived <- r
+				http.Error(w, "shadow failure", http.StatusInternalServerError)
+			}))
+			defer shadow.Close()
+
+			shadowEndpoint := shadow.URL
+			if test.shadowUnreachable {
+				shadowEndpoint = "http://" + testutil.GetAvailableLocalAddress(t)
+			}
+
+			cfg := &Config{
+				Ingress: confighttp.ServerConfig{
+					Endpoint: listenAt,
+				},
+				Egress: confighttp.ClientConfig{
+					Endpoint: backend.URL,
+				},
+				ShadowEgress: &confighttp.ClientConfig{
+					Endpoint: shadowEndpoint,
+					Headers: map[string]configopaque.String{
+						"shadow": "true",
+					},
+				},
+				InternalMetrics: &confighttp.ServerConfig{
+					Endpoint: metricsAt,
+				},
+			}
+			hf, err := newHTTPForwarder(cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			require.NoError(t, hf.Start(ctx, componenttest.NewNopHost()))
+
+			httpClient := http.Client{}
+			response, err := httpClient.Do(httpRequest(t, clientRequestArgs{
+				method: http.MethodPost,
+				url:    fmt.Sprintf("http://%s/api/dosomething", listenAt),
+				body:   "client_body",
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusAccepted, response.StatusCode)
+			assert.Equal(t, "primary", string(readBody(response.Body)))
+			require.NoError(t, response.Body.Close())
+
+			if !test.shadowUnreachable {
+				assert.Equal(t, test.expectedShadowBody, <-shadowBody)
+				r := <-shadowReceived
+				assert.Equal(t, "/api/dosomething", r.RequestURI)
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "true", r.Header.Get("shadow"))
+			}
+
+			// Shutdown waits for in-flight shadow requests.
+			require.NoError(t, hf.Shutdown(ctx))
+
+			body := string(readBody(promRegistryResponse(t, hf.(*httpForwarder))))
+			assert.Contains(t, body, test.expectedMetric)
+		})
+	}
+}
+
+func TestShadowRequestsStopOnShutdown(t *testing.T) {
+	var received atomic.Int32
+	started := make(chan struct{}, 1)
+	shadow := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		_ = readBody(r.Body)
+		started <- struct{}{}
+		// Hang until the forwarder gives up on the request.
+		<-r.Context().Done()
+	}))
+	defer shadow.Close()
+
+	hf, err := newHTTPForwarder(&Config{
+		Egress: confighttp.ClientConfig{
+			Endpoint: "http://localhost:9090",
+		},
+		ShadowEgress: &confighttp.ClientConfig{
+			Endpoint: shadow.URL,
+		},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	forwarder := hf.(*httpForwarder)
+	forwarder.shadowClient = shadow.Client()
+
+	request := httptest.NewRequest(http.MethodPost, "/api/dosomething", http.NoBody)
+	forwarder.shadowRequest(request, []byte("client_body"))
+	<-started
+
+	// The hanging shadow request is cancelled once the shutdown context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.NoError(t, hf.Shutdown(ctx))
+
+	// No shadow request is started after the shutdown.
+	forwarder.shadowRequest(request, []byte("client_body"))
+	forwarder.shutdownWG.Wait()
+	assert.Equal(t, int32(1), received.Load())
+}
+
+func TestFallbackResponse(t *testing.T) {
+	tests := []struct {
+		name                string
+		backendDown         bool
+		expectedStatusCode  int
+		expectedBody        string
+		expectedContentType string
+	}{
+		{
+			name:                "Backend unreachable",
+			backendDown:         true,
+			expectedStatusCode:  http.StatusServiceUnavailable,
+			expectedBody:        "down for maintenance",
+			expectedContentType: "text/html",
+		},
+		{
+			name:                "Backend error is passed through",
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedBody:        "backend failure\n",
+			expectedContentType: "text/plain; charset=utf-8",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			listenAt := testutil.GetAvailableLocalAddress(t)
+
+			backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, "backend failure", http.StatusInternalServerError)
+			}))
+			defer backend.Close()
+
+			egressEndpoint := backend.URL
+			if test.backendDown {
+				egressEndpoint = "http://" + testutil.GetAvailableLocalAddress(t)
+			}
+
+			cfg := &Config{
+				Ingress: confighttp.ServerConfig{
+					Endpoint: listenAt,
+				},
+				Egress: confighttp.ClientConfig{
+					Endpoint: egressEndpoint,
+				},
+				FallbackResponse: &FallbackResponseConfig{
+					StatusCode:  http.StatusServiceUnavailable,
+					Body:        "down for maintenance",
+					ContentType: "text/html",
+				},
+			}
+			hf, err := newHTTPForwarder(cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			require.NoError(t, hf.Start(ctx, componenttest.NewNopHost()))
+
+			httpClient := http.Client{}
+			response, err := httpClient.Do(httpRequest(t, clientRequestArgs{
+				method: http.MethodGet,
+				url:    fmt.Sprintf("http://%s/api/dosomething", listenAt),
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, response.StatusCode)
+			assert.Equal(t, test.expectedContentType, response.Header.Get("Content-Type"))
+			assert.Equal(t, test.expectedBody, string(readBody(response.Body)))
+			require.NoError(t, response.Body.Close())
+
+			require.NoError(t, hf.Shutdown(ctx))
+		})
+	}
+}
+
+func TestIdempotency(t *testing.T) {
+	listenAt := testutil.GetAvailableLocalAddress(t)
+
+	var forwarded atomic.Int32
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		forwarded.Add(1)
+		w.Header().Set("X-Backend", "true")
+		w.WriteHeader(http.StatusCreated)
+		_, err := w.Write([]byte("created"))
+		assert.NoError(t, err)
+	}))
+	defer backend.Close()
+
+	cfg := &Config{
+		Ingress: confighttp.ServerConfig{
+			Endpoint: listenAt,
+		},
+		Egress: confighttp.ClientConfig{
+			Endpoint: backend.URL,
+		},
+		Idempotency: &IdempotencyConfig{
+			Header: "X-Request-Key",
+		},
+	}
+	hf, err := newHTTPForwarder(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, hf.Start(ctx, componenttest.NewNopHost()))
+
+	httpClient := http.Client{}
+	send := func(key string) *http.Response {
+		headers := map[string]string{}
+		if key != "" {
+			headers["X-Request-Key"] = key
+		}
+		response, err := httpClient.Do(httpRequest(t, clientRequestArgs{
+			method:  http.MethodPost,
+			url:     fmt.Sprintf("http://%s/api/dosomething", listenAt),
+			headers: headers,
+		}))
+		require.NoError(t, err)
+		return response
+	}
+
+	first := send("key-1")
+	assert.Equal(t, http.StatusCreated, first.StatusCode)
+	assert.Equal(t, "created", string(readBody(first.Body)))
+	assert.Empty(t, first.Header.Get(idempotentReplayedHeader))
+	require.NoError(t, first.Body.Close())
+
+	replayed := send("key-1")
+	assert.Equal(t, http.StatusCreated, replayed.StatusCode)
+	assert.Equal(t, "created", string(readBody(replayed.Body)))
+	assert.Equal(t, "true", replayed.Header.Get("X-Backend"))
+	assert.Equal(t, "true", replayed.Header.Get(idempotentReplayedHeader))
+	require.NoError(t, replayed.Body.Close())
+	assert.Equal(t, int32(1), forwarded.Load())
+
+	// Requests with another key or without a key are forwarded.
+	for _, key := range []string{"key-2", ""} {
+		response := send(key)
+		assert.Equal(t, http.StatusCreated, response.StatusCode)
+		require.NoError(t, response.Body.Close())
+	}
+	assert.Equal(t, int32(3), forwarded.Load())
+
+	require.NoError(t, hf.Shutdown(ctx))
+}
+
+func TestIdempotencyInFlight(t *testing.T) {
+	hf, err := newHTTPForwarder(&Config{
+		Egress: confighttp.ClientConfig{
+			Endpoint: "http://localhost",
+		},
+		Idempotency: &IdempotencyConfig{},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	forwarder := hf.(*httpForwarder)
+
+	// Simulate a first request with the same key still being forwarded.
+	_, duplicate := forwarder.idempotency.begin("key-1", time.Now())
+	require.False(t, duplicate)
+
+	request := httptest.NewRequest(http.MethodPost, "/api/dosomething", http.NoBody)
+	request.Header.Set(defaultIdempotencyHeader, "key-1")
+	recorder := httptest.NewRecorder()
+	forwarder.forwardRequest(recorder, request)
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestIdempotencyForgetsIncompleteRequests(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte("created"))
+		assert.NoError(t, err)
+	}))
+	defer backend.Close()
+
+	hf, err := newHTTPForwarder(&Config{
+		Egress: confighttp.ClientConfig{
+			Endpoint: backend.URL,
+		},
+		ShadowEgress: &confighttp.ClientConfig{
+			Endpoint: backend.URL,
+		},
+		Idempotency: &IdempotencyConfig{},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	forwarder := hf.(*httpForwarder)
+	forwarder.httpClient = backend.Client()
+
+	tests := []struct {
+		name         string
+		body         io.Reader
+		writer       http.ResponseWriter
+		shadowClient *http.Client
+	}{
+		{
+			name:         "request body read failure",
+			body:         iotest.ErrReader(errors.New("read failed")),
+			writer:       httptest.NewRecorder(),
+			shadowClient: backend.Client(),
+		},
+		{
+			name:   "response body write failure",
+			body:   http.NoBody,
+			writer: &failingWriter{ResponseRecorder: httptest.NewRecorder()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forwarder.shadowClient = tt.shadowClient
+			request := httptest.NewRequest(http.MethodPost, "/api/dosomething", tt.body)
+			request.Header.Set(defaultIdempotencyHeader, "key-1")
+			forwarder.forwardRequest(tt.writer, request)
+
+			// The key was forgotten, so a retry is forwarded instead of being rejected or replayed.
+			_, duplicate := forwarder.idempotency.begin("key-1", time.Now())
+			assert.False(t, duplicate)
+			forwarder.idempotency.forget("key-1")
+		})
+	}
+}
+
+// failingWriter is a response writer failing to write the response body.
+type failingWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (*failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("client disconnected")
+}
+
+func TestDeadlineHeader(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name            string
+		config          *DeadlineHeaderConfig
+		egressTimeout   time.Duration
+		requestDeadline time.Duration
+		expectedHeader  string
+		expectedValue   string
+	}{
+		{
+			name:           "Egress timeout",
+			config:         &DeadlineHeaderConfig{},
+			egressTimeout:  10 * time.Second,
+			expectedHeader: defaultDeadlineHeader,
+			expectedValue:  "10000",
+		},
+		{
+			name:            "Earlier ingress request deadline",
+			config:          &DeadlineHeaderConfig{Name: "X-Deadline"},
+			egressTimeout:   10 * time.Second,
+			requestDeadline: 3 * time.Second,
+			expectedHeader:  "X-Deadline",
+			expectedValue:   "3000",
+		},
+		{
+			name:           "Absolute deadline",
+			config:         &DeadlineHeaderConfig{Format: deadlineFormatRFC3339},
+			egressTimeout:  1500 * time.Millisecond,
+			expectedHeader: defaultDeadlineHeader,
+			expectedValue:  "2024-01-01T12:00:01.5Z",
+		},
+		{
+			name:           "No deadline",
+			config:         &DeadlineHeaderConfig{},
+			expectedHeader: defaultDeadlineHeader,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hf, err := newHTTPForwarder(&Config{
+				Egress: confighttp.ClientConfig{
+					Endpoint: "http://localhost",
+					Timeout:  test.egressTimeout,
+				},
+				DeadlineHeader: test.config,
+			}, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			if test.requestDeadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithDeadline(ctx, now.Add(test.requestDeadline))
+				defer cancel()
+			}
+			request := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/dosomething", http.NoBody)
+			hf.(*httpForwarder).setDeadlineHeader(request, now)
+			assert.Equal(t, test.expectedValue, request.Header.Get(test.expectedHeader))
+		})
+	}
+}
+
+func TestDeadlineHeaderForwarded(t *testing.T) {
+	listenAt := testutil.GetAvailableLocalAddress(t)
+
+	deadlines := make(chan string, 1)
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadlines <- r.Header.Get(defaultDeadlineHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer backend.Close()
+
+	cfg := &Config{
+		Ingress: confighttp.ServerConfig{
+			Endpoint: listenAt,
+		},
+		Egress: confighttp.ClientConfig{
+			Endpoint: backend.URL,
+			Timeout:  5 * time.Second,
+		},
+		DeadlineHeader: &DeadlineHeaderConfig{},
+	}
+	hf, err := newHTTPForwarder(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, hf.Start(ctx, componenttest.NewNopHost()))
+
+	httpClient := http.Client{}
+	response, err := httpClient.Do(httpRequest(t, clientRequestArgs{
+		method: http.MethodGet,
+		url:    fmt.Sprintf("http://%s/api/dosomething", listenAt),
+	}))
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	remaining, err := strconv.Atoi(<-deadlines)
+	require.NoError(t, err)
+	assert.Positive(t, remaining)
+	assert.LessOrEqual(t, remaining, 5000)
+
+	require.NoError(t, hf.Shutdown(ctx))
+}
+
+func TestDeadlineHeaderStartsWithEgressRequest(t *testing.T) {
+	deadlines := make(chan string, 1)
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadlines <- r.Header.Get(defaultDeadlineHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer backend.Close()
+	shadow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer shadow.Close()
+
+	hf, err := newHTTPForwarder(&Config{
+		Egress: confighttp.ClientConfig{
+			Endpoint: backend.URL,
+			Timeout:  time.Second,
+		},
+		ShadowEgress: &confighttp.ClientConfig{
+			Endpoint: shadow.URL,
+		},
+		DeadlineHeader: &DeadlineHeaderConfig{
+			Format: deadlineFormatRFC3339,
+		},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	forwarder := hf.(*httpForwarder)
+	forwarder.httpClient = backend.Client()
+	// With a shadow backend, the body is read before the egress request is sent.
+	forwarder.shadowClient = shadow.Client()
+
+	received := time.Now()
+	request := httptest.NewRequest(http.MethodPost, "/api/dosomething", &slowBody{Reader: strings.NewReader("client_body"), delay: 500 * time.Millisecond})
+	forwarder.forwardRequest(httptest.NewRecorder(), request)
+
+	// Reading the body does not count against the egress timeout.
+	deadline, err := time.Parse(time.RFC3339Nano, <-deadlines)
+	require.NoError(t, err)
+	assert.False(t, deadline.Before(received.Add(1500*time.Millisecond)))
+	require.NoError(t, hf.Shutdown(context.Background()))
+}
+
+// slowBody is a request body whose content is only received after a delay.
+type slowBody struct {
+	io.Reader
+	delay time.Duration
+}
+
+func (b *slowBody) Read(p []byte) (int, error) {
+	time.Sleep(b.delay)
+	b.delay = 0
+	return b.Reader.Read(p)
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	listenAt := testutil.GetAvailableLocalAddress(t)
+
+	received := make(chan struct{})
+	unblock := make(chan struct{})
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		received <- struct{}{}
+		<-unblock
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer backend.Close()
+
+	cfg := &Config{
+		Ingress: confighttp.ServerConfig{
+			Endpoint: listenAt,
+		},
+		Egress: confighttp.ClientConfig{
+			Endpoint: backend.URL,
+		},
+		ConcurrencyLimit: &ConcurrencyLimitConfig{
+			MaxConcurrentForwards: 1,
+		},
+	}
+	hf, err := newHTTPForwarder(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, hf.Start(ctx, componenttest.NewNopHost()))
+
+	httpClient := http.Client{}
+	send := func() *http.Response {
+		response, err := httpClient.Do(httpRequest(t, clientRequestArgs{
+			method: http.MethodGet,
+			url:    fmt.Sprintf("http://%s/api/dosomething", listenAt),
+		}))
+		require.NoError(t, err)
+		return response
+	}
+
+	first := make(chan *http.Response)
+	go func() {
+		first <- send()
+	}()
+	<-received
+
+	// The only slot is taken by the first request.
+	rejected := send()
+	assert.Equal(t, http.StatusServiceUnavailable, rejected.StatusCode)
+	require.NoError(t, rejected.Body.Close())
+
+	close(unblock)
+	response := <-first
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	require.NoError(t, response.Body.Close())
+
+	// The slot is released once the first request is done.
+	go func() {
+		<-received
+	}()
+	response = send()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	require.NoError(t, response.Body.Close())
+
+	require.NoError(t, hf.Shutdown(ctx))
+}
+
+func promRegistryResponse(t *testing.T, hf *httpForwarder) io.ReadCloser {
+	recorder := httptest.NewRecorder()
+	hf.metrics.handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	return io.NopCloser(recorder.Body)
+}
+
 func httpRequest(t *testing.T, args clientRequestArgs) *http.Request {
 	r, err := http.NewRequest(args.method, args.url, io.NopCloser(strings.NewReader(args.body)))
 	require.NoError(t, err)
@@ -270,3 +914,151 @@ func getParsedURL(t *testing.T, rawURL string) *url.URL {
 	require.NoError(t, err)
 	return url
 }
+
+func TestPreserveHTTPSemanticsTrailers(t *testing.T) {
+	for _, preserve := range []bool{false, true} {
+		t.Run(fmt.Sprintf("preserve_http_semantics=%t", preserve), func(t *testing.T) {
+			listenAt := testutil.GetAvailableLocalAddress(t)
+
+			backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Trailer", "X-Checksum")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte("primary"))
+				assert.NoError(t, err)
+				w.Header().Set("X-Checksum", "abc123")
+			}))
+			defer backend.Close()
+
+			cfg := &Config{
+				Ingress: confighttp.ServerConfig{
+					Endpoint: listenAt,
+				},
+				Egress: confighttp.ClientConfig{
+					Endpoint: backend.URL,
+				},
+				PreserveHTTPSemantics: preserve,
+			}
+			hf, err := newHTTPForwarder(cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			require.NoError(t, hf.Start(ctx, componenttest.NewNopHost()))
+
+			httpClient := http.Client{}
+			response, err := httpClient.Do(httpRequest(t, clientRequestArgs{
+				method: http.MethodGet,
+				url:    fmt.Sprintf("http://%s/api/dosomething", listenAt),
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, "primary", string(readBody(response.Body)))
+			require.NoError(t, response.Body.Close())
+
+			// Trailers are only available once the body has been read.
+			if preserve {
+				assert.Equal(t, "abc123", response.Trailer.Get("X-Checksum"))
+			} else {
+				assert.Empty(t, response.Trailer.Get("X-Checksum"))
+			}
+
+			require.NoError(t, hf.Shutdown(ctx))
+		})
+	}
+}
+
+func TestPreserveHTTPSemanticsExpectContinue(t *testing.T) {
+	tests := []struct {
+		name               string
+		preserve           bool
+		backendRejects     bool
+		expectedStatusCode int
+		expectedShadowBody string
+	}{
+		{
+			name:               "Body is buffered for the shadow backend",
+			backendRejects:     true,
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+			expectedShadowBody: "client_body",
+		},
+		{
+			name:               "Backend rejects before the body is sent",
+			preserve:           true,
+			backendRejects:     true,
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:               "Backend accepts the body",
+			preserve:           true,
+			expectedStatusCode: http.StatusAccepted,
+			expectedShadowBody: "client_body",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			listenAt := testutil.GetAvailableLocalAddress(t)
+
+			expectHeaders := make(chan string, 1)
+			backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				expectHeaders <- r.Header.Get("Expect")
+				// Responding without reading the body tells the client not to send it.
+				if test.backendRejects {
+					w.WriteHeader(http.StatusRequestEntityTooLarge)
+					return
+				}
+				assert.Equal(t, "client_body", string(readBody(r.Body)))
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer backend.Close()
+
+			var shadowBodies []string
+			var shadowMu sync.Mutex
+			shadow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				shadowMu.Lock()
+				defer shadowMu.Unlock()
+				shadowBodies = append(shadowBodies, string(readBody(r.Body)))
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer shadow.Close()
+
+			cfg := &Config{
+				Ingress: confighttp.ServerConfig{
+					Endpoint: listenAt,
+				},
+				Egress: confighttp.ClientConfig{
+					Endpoint: backend.URL,
+				},
+				ShadowEgress: &confighttp.ClientConfig{
+					Endpoint: shadow.URL,
+				},
+				PreserveHTTPSemantics: test.preserve,
+			}
+			hf, err := newHTTPForwarder(cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			require.NoError(t, hf.Start(ctx, componenttest.NewNopHost()))
+
+			httpClient := http.Client{}
+			response, err := httpClient.Do(httpRequest(t, clientRequestArgs{
+				method:  http.MethodPost,
+				url:     fmt.Sprintf("http://%s/api/dosomething", listenAt),
+				headers: map[string]string{"Expect": "100-continue"},
+				body:    "client_body",
+			}))
+			require.NoError(t, err)
+			require.NoError(t, response.Body.Close())
+			assert.Equal(t, test.expectedStatusCode, response.StatusCode)
+			assert.Equal(t, "100-continue", <-expectHeaders)
+
+			// Shutdown waits for in-flight shadow requests.
+			require.NoError(t, hf.Shutdown(ctx))
+
+			shadowMu.Lock()
+			defer shadowMu.Unlock()
+			if test.expectedShadowBody == "" {
+				assert.Empty(t, shadowBodies)
+			} else {
+				assert.Equal(t, []string{test.expectedShadowBody}, shadowBodies)
+			}
+		})
+	}
+}
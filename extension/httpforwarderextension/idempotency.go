@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpforwarderextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpforwarderextension"
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultIdempotencyHeader    = "Idempotency-Key"
+	defaultIdempotencyWindow    = 5 * time.Minute
+	defaultIdempotencyCacheSize = 10000
+
+	// idempotentReplayedHeader is set on responses replayed from the cache.
+	idempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// idempotencyCache is a bounded LRU of the idempotency keys seen within the configured window.
+// A nil *idempotencyCache is valid and never reports duplicates.
+type idempotencyCache struct {
+	header string
+	window time.Duration
+	size   int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// order holds the entries, the most recently used first.
+	order *list.List
+}
+
+type idempotencyEntry struct {
+	key       string
+	expiresAt time.Time
+	// response is nil while the first request with the key is in flight.
+	response *cachedResponse
+}
+
+// cachedResponse is the response sent to the client for the first request with a key.
+type cachedResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+func newIdempotencyCache(cfg *IdempotencyConfig) *idempotencyCache {
+	c := &idempotencyCache{
+		header:  cfg.Header,
+		window:  cfg.Window,
+		size:    cfg.CacheSize,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+	}
+	if c.header == "" {
+		c.header = defaultIdempotencyHeader
+	}
+	if c.window == 0 {
+		c.window = defaultIdempotencyWindow
+	}
+	if c.size == 0 {
+		c.size = defaultIdempotencyCacheSize
+	}
+	return c
+}
+
+// key returns the idempotency key of the request, or an empty string when it has none.
+func (c *idempotencyCache) key(request *http.Request) string {
+	if c == nil {
+		return ""
+	}
+	return request.Header.Get(c.header)
+}
+
+// begin registers a request with the given key. It reports whether a request with the same
+// key was already received within the window, along with its response if it has completed.
+func (c *idempotencyCache) begin(key string, now time.Time) (*cachedResponse, bool) {
+	if c == nil || key == "" {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*idempotencyEntry)
+		if now.Before(entry.expiresAt) {
+			c.order.MoveToFront(element)
+			return entry.response, true
+		}
+		c.remove(element)
+	}
+
+	c.entries[key] = c.order.PushFront(&idempotencyEntry{key: key, expiresAt: now.Add(c.window)})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+	return nil, false
+}
+
+// complete stores the response sent for the request with the given key.
+func (c *idempotencyCache) complete(key string, response *cachedResponse) {
+	if c == nil || key == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*idempotencyEntry).response = response
+	}
+}
+
+// forget removes the key, so that a request with the same key is forwarded again.
+func (c *idempotencyCache) forget(key string) {
+	if c == nil || key == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+}
+
+func (c *idempotencyCache) remove(element *list.Element) {
+	delete(c.entries, element.Value.(*idempotencyEntry).key)
+	c.order.Remove(element)
+}
+
+// replay writes a cached response to the client.
+func (r *cachedResponse) replay(writer http.ResponseWriter) {
+	for k, v := range r.header {
+		writer.Header()[k] = v
+	}
+	writer.Header().Set(idempotentReplayedHeader, "true")
+	writer.WriteHeader(r.statusCode)
+	_, _ = writer.Write(r.body)
+}
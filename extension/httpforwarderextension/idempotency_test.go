@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpforwarderextension
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyCache(t *testing.T) {
+	c := newIdempotencyCache(&IdempotencyConfig{Window: time.Minute, CacheSize: 2})
+	now := time.Now()
+
+	response, duplicate := c.begin("a", now)
+	assert.False(t, duplicate)
+	assert.Nil(t, response)
+
+	// In flight.
+	response, duplicate = c.begin("a", now)
+	assert.True(t, duplicate)
+	assert.Nil(t, response)
+
+	// Completed.
+	c.complete("a", &cachedResponse{statusCode: http.StatusAccepted})
+	response, duplicate = c.begin("a", now)
+	assert.True(t, duplicate)
+	assert.Equal(t, http.StatusAccepted, response.statusCode)
+
+	// Expired.
+	_, duplicate = c.begin("a", now.Add(time.Minute))
+	assert.False(t, duplicate)
+
+	// Forgotten.
+	c.forget("a")
+	_, duplicate = c.begin("a", now)
+	assert.False(t, duplicate)
+
+	// The least recently used key is evicted.
+	_, duplicate = c.begin("b", now)
+	assert.False(t, duplicate)
+	_, duplicate = c.begin("a", now)
+	assert.True(t, duplicate)
+	_, duplicate = c.begin("c", now)
+	assert.False(t, duplicate)
+	_, duplicate = c.begin("b", now)
+	assert.False(t, duplicate)
+}
+
+func TestIdempotencyCacheDefaults(t *testing.T) {
+	c := newIdempotencyCache(&IdempotencyConfig{})
+	assert.Equal(t, defaultIdempotencyHeader, c.header)
+	assert.Equal(t, defaultIdempotencyWindow, c.window)
+	assert.Equal(t, defaultIdempotencyCacheSize, c.size)
+
+	var disabled *idempotencyCache
+	request, _ := http.NewRequest(http.MethodGet, "http://localhost", http.NoBody)
+	request.Header.Set(defaultIdempotencyHeader, "a")
+	assert.Empty(t, disabled.key(request))
+	_, duplicate := disabled.begin("a", time.Now())
+	assert.False(t, duplicate)
+}
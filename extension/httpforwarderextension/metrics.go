@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpforwarderextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpforwarderextension"
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "httpforwarder"
+
+// forwarderMetrics holds the metrics exposed on the internal metrics endpoint.
+// A nil *forwarderMetrics is valid and records nothing.
+type forwarderMetrics struct {
+	registry        *prometheus.Registry
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	shadowRequests  *prometheus.CounterVec
+}
+
+func newForwarderMetrics() *forwarderMetrics {
+	m := &forwarderMetrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "requests_total",
+			Help:      "Number of requests handled by the forwarder.",
+		}, []string{"method", "status_code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "request_duration_seconds",
+			Help:      "Time taken to forward a request and relay the response.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		shadowRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "shadow_requests_total",
+			Help:      "Number of requests mirrored to the shadow backend.",
+		}, []string{"result"}),
+	}
+	m.registry.MustRegister(m.requests, m.requestDuration, m.shadowRequests)
+	return m
+}
+
+// handler returns the HTTP handler serving the metrics in Prometheus format.
+func (m *forwarderMetrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// recordRequest records a handled request with the status code sent back to the client.
+func (m *forwarderMetrics) recordRequest(method string, statusCode int, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.requests.WithLabelValues(method, strconv.Itoa(statusCode)).Inc()
+	m.requestDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// recordShadowRequest records the outcome of a request mirrored to the shadow backend.
+func (m *forwarderMetrics) recordShadowRequest(err error) {
+	if m == nil {
+		return
+	}
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	m.shadowRequests.WithLabelValues(result).Inc()
+}
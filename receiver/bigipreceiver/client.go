@@ -6,16 +6,28 @@ package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collect
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"slices"
 	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configmiddleware"
+	"go.opentelemetry.io/collector/extension/extensionmiddleware"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
 )
@@ -23,6 +35,8 @@ import (
 const (
 	// loginPath is the path to the login endpoint
 	loginPath = "/mgmt/shared/authn/login"
+	// tokensPath is the path to the auth tokens endpoint, individual tokens are found under it by name
+	tokensPath = "/mgmt/shared/authz/tokens"
 	// virtualServersPath is the path to the virtual servers endpoint
 	virtualServersPath = "/mgmt/tm/ltm/virtual"
 	// virtualServersStatsPath is the path to the virtual servers statistics endpoint
@@ -31,6 +45,28 @@ const (
 	poolsStatsPath = "/mgmt/tm/ltm/pool/stats"
 	// nodesStatsPath is the path to the nodes statistics endpoint
 	nodesStatsPath = "/mgmt/tm/ltm/node/stats"
+	// clientSSLProfilesStatsPath is the path to the client SSL profiles statistics endpoint
+	clientSSLProfilesStatsPath = "/mgmt/tm/ltm/profile/client-ssl/stats"
+	// snatPoolsPath is the path to the SNAT pools endpoint
+	snatPoolsPath = "/mgmt/tm/ltm/snatpool"
+	// snatTranslationsStatsPath is the path to the SNAT translation addresses statistics endpoint
+	snatTranslationsStatsPath = "/mgmt/tm/ltm/snat-translation/stats"
+	// httpProfilesStatsPath is the path to the HTTP profiles statistics endpoint
+	httpProfilesStatsPath = "/mgmt/tm/ltm/profile/http/stats"
+	// trafficStatsPath is the path to the device wide traffic statistics endpoint
+	trafficStatsPath = "/mgmt/tm/sys/traffic/stats"
+	// tmmStatsPath is the path to the traffic management microkernel statistics endpoint
+	tmmStatsPath = "/mgmt/tm/sys/tmm-info/stats"
+	// systemStatsPath is the path to the system memory statistics endpoint
+	systemStatsPath = "/mgmt/tm/sys/memory/stats"
+	// interfaceStatsPath is the path to the network interfaces statistics endpoint
+	interfaceStatsPath = "/mgmt/tm/net/interface/stats"
+	// failoverStatusPath is the path to the failover status endpoint
+	failoverStatusPath = "/mgmt/tm/cm/failover-status"
+	// syncStatusPath is the path to the config sync status endpoint
+	syncStatusPath = "/mgmt/tm/cm/sync-status"
+	// devicesPath is the path to the devices of the trust domain endpoint
+	devicesPath = "/mgmt/tm/cm/device"
 	// poolMembersStatsPathSuffix is the suffix added onto an individual pool's statistics endpoint
 	poolMembersStatsPathSuffix = "/members/stats"
 )
@@ -44,8 +80,12 @@ var (
 type client interface {
 	// HasToken checks if the client currently has an auth token
 	HasToken() bool
+	// HasValidToken checks if the client currently has an auth token that does not expire within the refresh buffer
+	HasValidToken(refreshBuffer time.Duration) bool
 	// GetNewToken must be called initially as it retrieves and sets an auth token for future calls
 	GetNewToken(ctx context.Context) error
+	// DeleteToken invalidates the current auth token on the Big-IP and clears it from the client
+	DeleteToken(ctx context.Context) error
 	// GetVirtualServers retrieves data for all LTM virtual servers in a Big-IP environment
 	GetVirtualServers(ctx context.Context) (*models.VirtualServers, error)
 	// GetPools retrieves data for all LTM pools in a Big-IP environment
@@ -54,15 +94,69 @@ type client interface {
 	GetPoolMembers(ctx context.Context, pools *models.Pools) (*models.PoolMembers, error)
 	// GetNodes retrieves data for all LTM nodes in a Big-IP environment
 	GetNodes(ctx context.Context) (*models.Nodes, error)
+	// GetClientSSLProfiles retrieves data for all LTM client SSL profiles in a Big-IP environment
+	GetClientSSLProfiles(ctx context.Context) (*models.ClientSSLProfiles, error)
+	// GetSNATPools retrieves data for all SNAT pools in a Big-IP environment
+	GetSNATPools(ctx context.Context) (*models.SNATPools, error)
+	// GetHTTPProfiles retrieves data for all LTM HTTP profiles in a Big-IP environment
+	GetHTTPProfiles(ctx context.Context) (*models.HTTPProfiles, error)
+	// GetTrafficStats retrieves the device wide traffic data of a Big-IP environment
+	GetTrafficStats(ctx context.Context) (*models.TrafficStats, error)
+	// GetTMMStats retrieves data for all traffic management microkernels in a Big-IP environment
+	GetTMMStats(ctx context.Context) (*models.TMMStats, error)
+	// GetSystemStats retrieves the memory data of a Big-IP environment
+	GetSystemStats(ctx context.Context) (*models.SystemStats, error)
+	// GetInterfaces retrieves data for all network interfaces in a Big-IP environment
+	GetInterfaces(ctx context.Context) (*models.InterfaceStats, error)
+	// GetFailoverStatus retrieves the failover and config sync status of a Big-IP device
+	GetFailoverStatus(ctx context.Context) (*models.FailoverStatus, error)
+	// GetCustomStats retrieves data from an arbitrary statistics endpoint in a Big-IP environment
+	GetCustomStats(ctx context.Context, path string) (*models.CustomStats, error)
 }
 
 // bigipClient implements the client interface and retrieves data through the iControl REST API
 type bigipClient struct {
 	client       *http.Client
+	loginClient  *http.Client
 	hostEndpoint string
 	creds        bigipCredentials
-	token        string
 	logger       *zap.Logger
+
+	// maxRequestRetries is the number of times a GET request failing with a transient error is retried
+	maxRequestRetries int
+	// requestRetryBackoff is the time to wait between GET request attempts
+	requestRetryBackoff time.Duration
+
+	// useConditionalRequests enables conditional requests of inventory endpoints
+	useConditionalRequests bool
+	// cacheMu guards the responses cached for conditional requests
+	cacheMu sync.Mutex
+	// cachedResponses holds the last response of each inventory endpoint by path
+	cachedResponses map[string]*conditionalResponse
+
+	// tokenMu guards the token, which may be refreshed while requests are made concurrently
+	tokenMu sync.RWMutex
+	token   string
+	// tokenExpiration is zero when the expiration of the token is unknown
+	tokenExpiration time.Time
+	// tokenRefresh makes the concurrent requests rejected with the same token share a single login
+	tokenRefresh singleflight.Group
+}
+
+// conditionalResponse holds the raw payload of a conditional request together with its ETag
+type conditionalResponse struct {
+	etag        string
+	payload     json.RawMessage
+	notModified bool
+}
+
+// statusCodeError is returned when the iControl REST API responds with a non 200 status code
+type statusCodeError struct {
+	statusCode int
+}
+
+func (e *statusCodeError) Error() string {
+	return fmt.Sprintf("non 200 code returned %d", e.statusCode)
 }
 
 // bigipCredentials stores the username and password needed to retrieve an access token from the iControl REST API
@@ -76,25 +170,55 @@ var _ client = (*bigipClient)(nil)
 
 // newClient creates an initialized client (but with no token)
 func newClient(ctx context.Context, cfg *Config, host component.Host, settings component.TelemetrySettings, logger *zap.Logger) (client, error) {
-	httpClient, err := cfg.ToClient(ctx, host, settings)
+	clientConfig := cfg.ClientConfig
+	if cfg.PinnedCertSHA256 != "" {
+		var err error
+		if clientConfig, host, err = withPinnedCert(cfg, host); err != nil {
+			return nil, fmt.Errorf("failed to create HTTP Client: %w", err)
+		}
+	}
+	httpClient, err := clientConfig.ToClient(ctx, host, settings)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP Client: %w", err)
 	}
 
+	// Token requests share the transport of the stats requests but may use a different timeout
+	loginClient := httpClient
+	if cfg.LoginTimeout > 0 {
+		loginClient = &http.Client{
+			Transport:     httpClient.Transport,
+			CheckRedirect: httpClient.CheckRedirect,
+			Jar:           httpClient.Jar,
+			Timeout:       cfg.LoginTimeout,
+		}
+	}
+
 	return &bigipClient{
 		client:       httpClient,
+		loginClient:  loginClient,
 		hostEndpoint: cfg.Endpoint,
 		creds: bigipCredentials{
 			username: cfg.Username,
 			password: string(cfg.Password),
 		},
-		logger: logger,
+		logger:                 logger,
+		maxRequestRetries:      cfg.MaxRequestRetries,
+		requestRetryBackoff:    cfg.RequestRetryBackoff,
+		useConditionalRequests: cfg.UseConditionalRequests,
+		cachedResponses:        make(map[string]*conditionalResponse),
 	}, nil
 }
 
 // HasToken checks to see if an auth token has been set for the client
 func (c *bigipClient) HasToken() bool {
-	return c.token != ""
+	return c.currentToken() != ""
+}
+
+// currentToken returns the auth token of the client, or an empty string when it has none
+func (c *bigipClient) currentToken() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+	return c.token
 }
 
 // GetNewToken makes an appropriate call to the iControl REST login endpoint and sets the returned token on the bigipClient
@@ -106,7 +230,40 @@ func (c *bigipClient) GetNewToken(ctx context.Context) error {
 		return err
 	}
 
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
 	c.token = tokenDetails.Token.Token
+	c.tokenExpiration = time.Time{}
+	if tokenDetails.Token.ExpirationMicros > 0 {
+		c.tokenExpiration = time.UnixMicro(tokenDetails.Token.ExpirationMicros)
+	}
+	return nil
+}
+
+// HasValidToken checks to see if an auth token has been set for the client which is not about to expire.
+// Tokens of unknown expiration are never considered valid, so that they are replaced on every scrape.
+func (c *bigipClient) HasValidToken(refreshBuffer time.Duration) bool {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+	if c.token == "" || c.tokenExpiration.IsZero() {
+		return false
+	}
+	return time.Now().Add(refreshBuffer).Before(c.tokenExpiration)
+}
+
+// DeleteToken makes a call to delete the current token from the iControl REST tokens endpoint and clears it on the bigipClient
+func (c *bigipClient) DeleteToken(ctx context.Context) error {
+	var token *models.Token
+
+	if err := c.delete(ctx, tokensPath+"/"+c.currentToken(), &token); err != nil {
+		c.logger.Debug("Failed to delete api token", zap.Error(err))
+		return err
+	}
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.token = ""
+	c.tokenExpiration = time.Time{}
 	return nil
 }
 
@@ -124,7 +281,7 @@ func (c *bigipClient) GetVirtualServers(ctx context.Context) (*models.VirtualSer
 	// get statistic virtual server details and combine them
 	var virtualServersDetails *models.VirtualServersDetails
 
-	if err := c.get(ctx, virtualServersPath, &virtualServersDetails); err != nil {
+	if err := c.getConditional(ctx, virtualServersPath, &virtualServersDetails); err != nil {
 		c.logger.Warn("Failed to retrieve virtual servers properties", zap.Error(err))
 		return virtualServers, nil
 	}
@@ -187,6 +344,168 @@ func (c *bigipClient) GetNodes(ctx context.Context) (nodes *models.Nodes, err er
 	return nodes, nil
 }
 
+// GetClientSSLProfiles makes a call the statistics version of the client SSL profiles endpoint and returns the data.
+func (c *bigipClient) GetClientSSLProfiles(ctx context.Context) (profiles *models.ClientSSLProfiles, err error) {
+	if err = c.get(ctx, clientSSLProfilesStatsPath, &profiles); err != nil {
+		c.logger.Debug("Failed to retrieve client SSL profiles", zap.Error(err))
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// GetSNATPools makes calls to both the SNAT pools endpoint and the statistics version of the SNAT translation addresses endpoint.
+// It sums up the statistics of the translation addresses of each pool. Big-IP versions missing one of the endpoints have no SNAT pools.
+func (c *bigipClient) GetSNATPools(ctx context.Context) (*models.SNATPools, error) {
+	var snatPoolsDetails *models.SNATPoolsDetails
+	if err := c.get(ctx, snatPoolsPath, &snatPoolsDetails); err != nil {
+		if isNotFound(err) {
+			c.logger.Debug("SNAT pools are not available on this Big-IP version", zap.Error(err))
+			return &models.SNATPools{}, nil
+		}
+		c.logger.Debug("Failed to retrieve SNAT pools", zap.Error(err))
+		return nil, err
+	}
+
+	var translations *models.SNATTranslations
+	if err := c.get(ctx, snatTranslationsStatsPath, &translations); err != nil {
+		if isNotFound(err) {
+			c.logger.Debug("SNAT translation statistics are not available on this Big-IP version", zap.Error(err))
+			return &models.SNATPools{}, nil
+		}
+		c.logger.Debug("Failed to retrieve SNAT translation statistics", zap.Error(err))
+		return nil, err
+	}
+
+	connections := make(map[string]int64, len(translations.Entries))
+	for _, entry := range translations.Entries {
+		connections[entry.NestedStats.Entries.Name.Description] = entry.NestedStats.Entries.ServersideCurConns.Value
+	}
+
+	snatPools := &models.SNATPools{Pools: make([]models.SNATPool, 0, len(snatPoolsDetails.Items))}
+	for _, item := range snatPoolsDetails.Items {
+		pool := models.SNATPool{
+			Name:                 item.FullPath,
+			TranslationAddresses: int64(len(item.Members)),
+		}
+		for _, member := range item.Members {
+			pool.Connections += connections[member]
+		}
+		snatPools.Pools = append(snatPools.Pools, pool)
+	}
+
+	return snatPools, nil
+}
+
+// isNotFound reports whether the error was caused by requesting an endpoint that does not exist on the Big-IP
+func isNotFound(err error) bool {
+	var statusErr *statusCodeError
+	return errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound
+}
+
+// GetHTTPProfiles makes a call the statistics version of the HTTP profiles endpoint and returns the data.
+func (c *bigipClient) GetHTTPProfiles(ctx context.Context) (profiles *models.HTTPProfiles, err error) {
+	if err = c.get(ctx, httpProfilesStatsPath, &profiles); err != nil {
+		c.logger.Debug("Failed to retrieve HTTP profiles", zap.Error(err))
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// GetTrafficStats makes a call the device wide traffic statistics endpoint and returns the data.
+func (c *bigipClient) GetTrafficStats(ctx context.Context) (stats *models.TrafficStats, err error) {
+	if err = c.get(ctx, trafficStatsPath, &stats); err != nil {
+		c.logger.Debug("Failed to retrieve traffic stats", zap.Error(err))
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetTMMStats makes a call the traffic management microkernel statistics endpoint and returns the data.
+func (c *bigipClient) GetTMMStats(ctx context.Context) (stats *models.TMMStats, err error) {
+	if err = c.get(ctx, tmmStatsPath, &stats); err != nil {
+		c.logger.Debug("Failed to retrieve TMM stats", zap.Error(err))
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetSystemStats makes a call the system memory statistics endpoint and returns the data.
+func (c *bigipClient) GetSystemStats(ctx context.Context) (stats *models.SystemStats, err error) {
+	if err = c.get(ctx, systemStatsPath, &stats); err != nil {
+		c.logger.Debug("Failed to retrieve system stats", zap.Error(err))
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetInterfaces makes a call the network interfaces statistics endpoint and returns the data.
+func (c *bigipClient) GetInterfaces(ctx context.Context) (stats *models.InterfaceStats, err error) {
+	if err = c.get(ctx, interfaceStatsPath, &stats); err != nil {
+		c.logger.Debug("Failed to retrieve interface stats", zap.Error(err))
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetFailoverStatus makes calls to the failover status, config sync status and devices endpoints.
+// The devices of the trust domain are only used to find the name of the device the calls were made to.
+func (c *bigipClient) GetFailoverStatus(ctx context.Context) (*models.FailoverStatus, error) {
+	var failoverStatus *models.CMStatus
+	if err := c.get(ctx, failoverStatusPath, &failoverStatus); err != nil {
+		c.logger.Debug("Failed to retrieve failover status", zap.Error(err))
+		return nil, err
+	}
+
+	var syncStatus *models.CMStatus
+	if err := c.get(ctx, syncStatusPath, &syncStatus); err != nil {
+		c.logger.Debug("Failed to retrieve config sync status", zap.Error(err))
+		return nil, err
+	}
+
+	var devices *models.Devices
+	if err := c.get(ctx, devicesPath, &devices); err != nil {
+		c.logger.Debug("Failed to retrieve devices", zap.Error(err))
+		return nil, err
+	}
+
+	status := &models.FailoverStatus{
+		FailoverStatus: cmStatusDescription(failoverStatus),
+		SyncStatus:     cmStatusDescription(syncStatus),
+	}
+	for _, device := range devices.Items {
+		if device.SelfDevice == "true" {
+			status.Device = device.Name
+			break
+		}
+	}
+
+	return status, nil
+}
+
+// cmStatusDescription returns the status reported by a cm status endpoint, which has a single entry
+func cmStatusDescription(status *models.CMStatus) string {
+	for _, entry := range status.Entries {
+		return entry.NestedStats.Entries.Status.Description
+	}
+	return ""
+}
+
+// GetCustomStats makes a call to the passed in statistics path and returns the data.
+func (c *bigipClient) GetCustomStats(ctx context.Context, path string) (stats *models.CustomStats, err error) {
+	if err = c.get(ctx, path, &stats); err != nil {
+		c.logger.Debug("Failed to retrieve custom stats", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 // post makes a POST request for the passed in path and stores result in the respObj
 func (c *bigipClient) post(ctx context.Context, path string, respObj any) error {
 	// Construct endpoint and create request
@@ -202,26 +521,206 @@ func (c *bigipClient) post(ctx context.Context, path string, respObj any) error
 		return fmt.Errorf("failed to create post request for path %s: %w", path, err)
 	}
 
-	return c.makeHTTPRequest(req, respObj)
+	return c.makeHTTPRequest(c.loginClient, req, respObj)
 }
 
-// get makes a GET request (with token in header) for the passed in path and stores result in the respObj
+// get makes a GET request (with token in header) for the passed in path and stores result in the respObj.
+// When the token is rejected, a new one is retrieved and the request is made once more.
 func (c *bigipClient) get(ctx context.Context, path string, respObj any) error {
+	token := c.currentToken()
+	err := c.getWithRetries(ctx, path, respObj)
+
+	var statusErr *statusCodeError
+	if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusUnauthorized {
+		c.logger.Debug("Api token was rejected, retrieving a new one", zap.String("path", path))
+		if tokenErr := c.refreshToken(ctx, token); tokenErr != nil {
+			return err
+		}
+		err = c.getWithRetries(ctx, path, respObj)
+	}
+
+	return err
+}
+
+// refreshToken retrieves a new token to replace the rejected one. Concurrent callers rejected with the same
+// token share a single login, and the token is not replaced again once another caller already replaced it.
+func (c *bigipClient) refreshToken(ctx context.Context, rejected string) error {
+	_, err, _ := c.tokenRefresh.Do(rejected, func() (any, error) {
+		if c.currentToken() != rejected {
+			return nil, nil
+		}
+		return nil, c.GetNewToken(ctx)
+	})
+	return err
+}
+
+// getWithRetries makes a GET request with the current token, retrying it when it fails with a transient error
+func (c *bigipClient) getWithRetries(ctx context.Context, path string, respObj any) error {
+	for attempt := 0; ; attempt++ {
+		err := c.getWithToken(ctx, path, respObj)
+		if err == nil || attempt >= c.maxRequestRetries || !isTransient(err) {
+			return err
+		}
+
+		c.logger.Debug("Big-IP API request failed with a transient error, retrying", zap.String("path", path), zap.Int("attempt", attempt+1), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(c.requestRetryBackoff):
+		}
+	}
+}
+
+// isTransient reports whether the error was caused by a server side failure or a reset connection,
+// which are expected to go away on their own. Client side failures such as a rejected token are not transient.
+func isTransient(err error) bool {
+	var statusErr *statusCodeError
+	if errors.As(err, &statusErr) {
+		return statusErr.statusCode >= http.StatusInternalServerError
+	}
+	return errors.Is(err, syscall.ECONNRESET)
+}
+
+// pinnedCertMiddlewareID identifies the middleware verifying the pinned certificate, it is only known to the host
+// passed to confighttp when creating the client
+var pinnedCertMiddlewareID = component.MustNewID("bigip_pinned_cert")
+
+// pinnedCertMiddleware is the HTTP client middleware verifying the server certificate against the pinned fingerprint
+type pinnedCertMiddleware struct {
+	component.StartFunc
+	component.ShutdownFunc
+	extensionmiddleware.GetHTTPRoundTripperFunc
+}
+
+// pinnedCertHost exposes the pinned certificate middleware along with the extensions of the collector host
+type pinnedCertHost struct {
+	component.Host
+	middleware component.Component
+}
+
+func (h *pinnedCertHost) GetExtensions() map[component.ID]component.Component {
+	extensions := map[component.ID]component.Component{}
+	if h.Host != nil {
+		maps.Copy(extensions, h.Host.GetExtensions())
+	}
+	extensions[pinnedCertMiddlewareID] = h.middleware
+	return extensions
+}
+
+// withPinnedCert returns the client config and host creating an HTTP client that only accepts the server
+// certificate with the pinned fingerprint. The client is created by confighttp with all its settings, the
+// pinned certificate middleware being the innermost round tripper, it only replaces the verification of the
+// certificate chain of the transport with the fingerprint check, which allows connecting to Big-IPs using
+// self-signed certificates.
+func withPinnedCert(cfg *Config, host component.Host) (confighttp.ClientConfig, component.Host, error) {
+	fingerprint, err := parseCertFingerprint(cfg.PinnedCertSHA256)
+	if err != nil {
+		return confighttp.ClientConfig{}, nil, err
+	}
+
+	middleware := &pinnedCertMiddleware{
+		GetHTTPRoundTripperFunc: func(base http.RoundTripper) (http.RoundTripper, error) {
+			transport, ok := base.(*http.Transport)
+			if !ok {
+				return nil, fmt.Errorf("cannot pin the server certificate on a %T transport", base)
+			}
+			tlsConfig := transport.TLSClientConfig
+			if tlsConfig == nil {
+				tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+			} else {
+				tlsConfig = tlsConfig.Clone()
+			}
+			// the chain is replaced by the fingerprint check below
+			tlsConfig.InsecureSkipVerify = true //nolint:gosec
+			tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+				if len(rawCerts) == 0 {
+					return errors.New("no server certificate presented")
+				}
+				if sum := sha256.Sum256(rawCerts[0]); !bytes.Equal(sum[:], fingerprint) {
+					return fmt.Errorf("server certificate fingerprint %x does not match the pinned fingerprint", sum)
+				}
+				return nil
+			}
+			transport.TLSClientConfig = tlsConfig
+			return transport, nil
+		},
+	}
+
+	// middlewares are applied in reverse order, so the last one wraps the transport directly
+	clientConfig := cfg.ClientConfig
+	clientConfig.Middlewares = append(slices.Clone(cfg.Middlewares), configmiddleware.Config{ID: pinnedCertMiddlewareID})
+	return clientConfig, &pinnedCertHost{Host: host, middleware: middleware}, nil
+}
+
+// getConditional works like get, but when conditional requests are enabled it sends the ETag of the previous response
+// for the path and decodes the previous payload again when the Big-IP reports that it has not changed
+func (c *bigipClient) getConditional(ctx context.Context, path string, respObj any) error {
+	if !c.useConditionalRequests {
+		return c.get(ctx, path, respObj)
+	}
+
+	c.cacheMu.Lock()
+	cached := c.cachedResponses[path]
+	c.cacheMu.Unlock()
+
+	resp := &conditionalResponse{}
+	if cached != nil {
+		resp.etag = cached.etag
+	}
+	if err := c.get(ctx, path, resp); err != nil {
+		return err
+	}
+
+	if resp.notModified {
+		// only cached responses are requested conditionally, so the payload is always available here
+		return json.Unmarshal(cached.payload, respObj)
+	}
+
+	c.cacheMu.Lock()
+	if resp.etag != "" {
+		c.cachedResponses[path] = resp
+	} else {
+		// the Big-IP does not support conditional requests for the path
+		delete(c.cachedResponses, path)
+	}
+	c.cacheMu.Unlock()
+
+	return json.Unmarshal(resp.payload, respObj)
+}
+
+// getWithToken makes a single GET request with the current token for the passed in path and stores result in the respObj
+func (c *bigipClient) getWithToken(ctx context.Context, path string, respObj any) error {
 	// Construct endpoint and create request
 	url := c.hostEndpoint + path
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
-	req.Header.Add("X-F5-Auth-Token", c.token)
 	if err != nil {
 		return fmt.Errorf("failed to create get request for path %s: %w", path, err)
 	}
+	req.Header.Add("X-F5-Auth-Token", c.currentToken())
+	if conditional, ok := respObj.(*conditionalResponse); ok && conditional.etag != "" {
+		req.Header.Add("If-None-Match", conditional.etag)
+	}
 
-	return c.makeHTTPRequest(req, respObj)
+	return c.makeHTTPRequest(c.client, req, respObj)
 }
 
-// makeHTTPRequest makes the request and decodes the body into the respObj on a 200 Status
-func (c *bigipClient) makeHTTPRequest(req *http.Request, respObj any) (err error) {
+// delete makes a DELETE request (with token in header) for the passed in path and stores result in the respObj
+func (c *bigipClient) delete(ctx context.Context, path string, respObj any) error {
+	// Construct endpoint and create request
+	url := c.hostEndpoint + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create delete request for path %s: %w", path, err)
+	}
+	req.Header.Add("X-F5-Auth-Token", c.currentToken())
+
+	return c.makeHTTPRequest(c.loginClient, req, respObj)
+}
+
+// makeHTTPRequest makes the request with the given HTTP client and decodes the body into the respObj on a 200 Status
+func (c *bigipClient) makeHTTPRequest(httpClient *http.Client, req *http.Request, respObj any) (err error) {
 	// Make request
-	resp, err := c.client.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to make http request: %w", err)
 	}
@@ -233,11 +732,26 @@ func (c *bigipClient) makeHTTPRequest(req *http.Request, respObj any) (err error
 		}
 	}()
 
+	// Conditional requests keep the raw payload along with its ETag, or only record that it has not changed
+	conditional, isConditional := respObj.(*conditionalResponse)
+	if isConditional {
+		if resp.StatusCode == http.StatusNotModified && conditional.etag != "" {
+			conditional.notModified = true
+			return nil
+		}
+		respObj = &conditional.payload
+	}
+
 	// Check for OK status code
 	if err = c.checkHTTPStatus(resp); err != nil {
 		return err
 	}
 
+	// The ETag is only kept from successful responses, so that a retried request is still conditional
+	if isConditional {
+		conditional.etag = resp.Header.Get("ETag")
+	}
+
 	// Decode the payload into the passed in response object
 	if err := json.NewDecoder(resp.Body).Decode(respObj); err != nil {
 		return fmt.Errorf("failed to decode response payload: %w", err)
@@ -259,7 +773,7 @@ func (c *bigipClient) checkHTTPStatus(resp *http.Response) (err error) {
 			c.logger.Debug("Big-IP API Error", zap.ByteString("api_error", payloadData))
 		}
 
-		return fmt.Errorf("non 200 code returned %d", resp.StatusCode)
+		return &statusCodeError{statusCode: resp.StatusCode}
 	}
 
 	return nil
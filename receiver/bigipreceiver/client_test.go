@@ -5,20 +5,29 @@ package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.uber.org/zap"
 
@@ -35,6 +44,18 @@ const (
 	poolMembersStatsResponse2File   = "get_pool_members_stats_response_2.json"
 	poolMembersCombinedFile         = "pool_members_combined.json"
 	nodesStatsResponseFile          = "get_nodes_stats_response.json"
+	clientSSLProfilesResponseFile   = "get_client_ssl_profiles_stats_response.json"
+	httpProfilesResponseFile        = "get_http_profiles_stats_response.json"
+	trafficStatsResponseFile        = "get_traffic_stats_response.json"
+	tmmStatsResponseFile            = "get_tmm_stats_response.json"
+	systemStatsResponseFile         = "get_system_stats_response.json"
+	interfaceStatsResponseFile      = "get_interface_stats_response.json"
+	snatPoolsResponseFile           = "get_snat_pools_response.json"
+	snatTranslationsResponseFile    = "get_snat_translations_stats_response.json"
+	snatPoolsCombinedFile           = "snat_pools_combined.json"
+	failoverStatusResponseFile      = "get_failover_status_response.json"
+	syncStatusResponseFile          = "get_sync_status_response.json"
+	devicesResponseFile             = "get_devices_response.json"
 )
 
 func TestNewClient(t *testing.T) {
@@ -78,6 +99,17 @@ func TestNewClient(t *testing.T) {
 			logger:      zap.NewNop(),
 			expectError: nil,
 		},
+		{
+			desc: "Valid Configuration With Login Timeout",
+			cfg: &Config{
+				ClientConfig: clientConfig,
+				LoginTimeout: time.Minute,
+			},
+			host:        componenttest.NewNopHost(),
+			settings:    componenttest.NewNopTelemetrySettings(),
+			logger:      zap.NewNop(),
+			expectError: nil,
+		},
 	}
 
 	for _, tc := range testCase {
@@ -97,11 +129,41 @@ func TestNewClient(t *testing.T) {
 				require.Equal(t, tc.cfg.Endpoint, actualClient.hostEndpoint)
 				require.Equal(t, tc.logger, actualClient.logger)
 				require.NotNil(t, actualClient.client)
+				if tc.cfg.LoginTimeout > 0 {
+					require.Equal(t, tc.cfg.LoginTimeout, actualClient.loginClient.Timeout)
+					require.Equal(t, tc.cfg.ClientConfig.Timeout, actualClient.client.Timeout)
+					require.Same(t, actualClient.client.Transport, actualClient.loginClient.Transport)
+				} else {
+					require.Same(t, actualClient.client, actualClient.loginClient)
+				}
 			}
 		})
 	}
 }
 
+func TestNewClientPinnedCertKeepsClientSettings(t *testing.T) {
+	data := loadAPIResponseData(t, loginResponseFile)
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "collector", r.Header.Get("X-Forwarded-By"))
+		_, err := w.Write(data)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+	certSum := sha256.Sum256(ts.Certificate().Raw)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Headers = map[string]configopaque.String{"X-Forwarded-By": "collector"}
+	cfg.MaxIdleConns = 7
+	cfg.PinnedCertSHA256 = hex.EncodeToString(certSum[:])
+
+	tc, err := newClient(context.Background(), cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(), zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, tc.GetNewToken(context.Background()))
+	require.True(t, tc.HasToken())
+	assert.Empty(t, cfg.Middlewares, "the configuration should not be modified")
+}
+
 func TestGetNewToken(t *testing.T) {
 	testCases := []struct {
 		desc     string
@@ -142,6 +204,30 @@ func TestGetNewToken(t *testing.T) {
 				require.False(t, hasToken)
 			},
 		},
+		{
+			desc: "Login timeout exceeded",
+			testFunc: func(t *testing.T) {
+				// Setup test server that only responds once the test is done
+				done := make(chan struct{})
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					<-done
+					w.WriteHeader(http.StatusOK)
+				}))
+				defer ts.Close()
+				defer close(done)
+
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = ts.URL
+				cfg.LoginTimeout = 10 * time.Millisecond
+				tc, err := newClient(context.Background(), cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(), zap.NewNop())
+				require.NoError(t, err)
+
+				err = tc.GetNewToken(context.Background())
+				require.ErrorContains(t, err, "Client.Timeout exceeded")
+				hasToken := tc.HasToken()
+				require.False(t, hasToken)
+			},
+		},
 		{
 			desc: "Successful call",
 			testFunc: func(t *testing.T) {
@@ -169,6 +255,312 @@ func TestGetNewToken(t *testing.T) {
 	}
 }
 
+func TestHasValidToken(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		expiration    time.Duration
+		refreshBuffer time.Duration
+		expected      bool
+	}{
+		{
+			desc:          "Token far from expiring",
+			expiration:    20 * time.Minute,
+			refreshBuffer: time.Minute,
+			expected:      true,
+		},
+		{
+			desc:          "Token expiring within the refresh buffer",
+			expiration:    30 * time.Second,
+			refreshBuffer: time.Minute,
+			expected:      false,
+		},
+		{
+			desc:          "Expired token",
+			expiration:    -time.Minute,
+			refreshBuffer: 0,
+			expected:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			expirationMicros := time.Now().Add(tc.expiration).UnixMicro()
+			// Setup test server
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, err := fmt.Fprintf(w, `{"token":{"token":"test-token","expirationMicros":%d}}`, expirationMicros)
+				assert.NoError(t, err)
+			}))
+			defer ts.Close()
+
+			testClient := createTestClient(t, ts.URL)
+			require.False(t, testClient.HasValidToken(tc.refreshBuffer))
+
+			err := testClient.GetNewToken(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, testClient.HasValidToken(tc.refreshBuffer))
+		})
+	}
+
+	t.Run("Token without expiration", func(t *testing.T) {
+		// Setup test server
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, err := w.Write([]byte(`{"token":{"token":"test-token"}}`))
+			assert.NoError(t, err)
+		}))
+		defer ts.Close()
+
+		tc := createTestClient(t, ts.URL)
+		err := tc.GetNewToken(context.Background())
+		require.NoError(t, err)
+		require.True(t, tc.HasToken())
+		require.False(t, tc.HasValidToken(0))
+	})
+}
+
+func TestTokenRefreshOnUnauthorized(t *testing.T) {
+	var logins atomic.Int32
+	// Setup test server accepting only the second token
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == loginPath {
+			_, err := fmt.Fprintf(w, `{"token":{"token":"token-%d"}}`, logins.Add(1))
+			assert.NoError(t, err)
+			return
+		}
+		if r.Header.Get("X-F5-Auth-Token") != "token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := w.Write([]byte(`{"entries":{}}`))
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	tc := createTestClient(t, ts.URL)
+	err := tc.GetNewToken(context.Background())
+	require.NoError(t, err)
+
+	stats, err := tc.GetTrafficStats(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+	require.Equal(t, int32(2), logins.Load())
+}
+
+func TestConcurrentTokenRefreshSharesLogin(t *testing.T) {
+	const requests = 3
+	var logins atomic.Int32
+	var rejections sync.WaitGroup
+	rejections.Add(requests)
+	// Setup test server rejecting the first token once all the requests were made with it
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == loginPath {
+			_, err := fmt.Fprintf(w, `{"token":{"token":"token-%d"}}`, logins.Add(1))
+			assert.NoError(t, err)
+			return
+		}
+		if r.Header.Get("X-F5-Auth-Token") != "token-2" {
+			rejections.Done()
+			rejections.Wait()
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := w.Write([]byte(`{"entries":{}}`))
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	tc := createTestClient(t, ts.URL)
+	require.NoError(t, tc.GetNewToken(context.Background()))
+
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := tc.GetTrafficStats(context.Background())
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	// The initial login and a single login shared by the rejected requests
+	require.Equal(t, int32(2), logins.Load())
+}
+
+func TestGetRequestRetries(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		responses        []func() (*http.Response, error)
+		expectedAttempts int
+		expectedErr      string
+	}{
+		{
+			desc: "5xx Retried Until Success",
+			responses: []func() (*http.Response, error){
+				statusResponse(http.StatusServiceUnavailable),
+				statusResponse(http.StatusInternalServerError),
+				statusResponse(http.StatusOK),
+			},
+			expectedAttempts: 3,
+		},
+		{
+			desc: "Connection Reset Retried Until Success",
+			responses: []func() (*http.Response, error){
+				errorResponse(syscall.ECONNRESET),
+				errorResponse(syscall.ECONNRESET),
+				statusResponse(http.StatusOK),
+			},
+			expectedAttempts: 3,
+		},
+		{
+			desc: "Retries Exhausted",
+			responses: []func() (*http.Response, error){
+				statusResponse(http.StatusServiceUnavailable),
+				statusResponse(http.StatusServiceUnavailable),
+				statusResponse(http.StatusServiceUnavailable),
+				statusResponse(http.StatusOK),
+			},
+			expectedAttempts: 3,
+			expectedErr:      "non 200 code returned 503",
+		},
+		{
+			desc: "4xx Not Retried",
+			responses: []func() (*http.Response, error){
+				statusResponse(http.StatusNotFound),
+				statusResponse(http.StatusOK),
+			},
+			expectedAttempts: 1,
+			expectedErr:      "non 200 code returned 404",
+		},
+		{
+			desc: "Other Connection Error Not Retried",
+			responses: []func() (*http.Response, error){
+				errorResponse(syscall.ECONNREFUSED),
+				statusResponse(http.StatusOK),
+			},
+			expectedAttempts: 1,
+			expectedErr:      "failed to make http request: Get \"https://bigip:443/mgmt/tm/sys/traffic/stats\": connection refused",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var attempts int
+			retryClient := &bigipClient{
+				client: &http.Client{
+					Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+						response := tc.responses[attempts]
+						attempts++
+						return response()
+					}),
+				},
+				hostEndpoint:        "https://bigip:443",
+				logger:              zap.NewNop(),
+				maxRequestRetries:   2,
+				requestRetryBackoff: time.Millisecond,
+			}
+
+			stats, err := retryClient.GetTrafficStats(context.Background())
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.NotNil(t, stats)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+			require.Equal(t, tc.expectedAttempts, attempts)
+		})
+	}
+}
+
+// roundTripperFunc is an http.RoundTripper serving requests with a function
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func statusResponse(statusCode int) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		return &http.Response{
+			StatusCode: statusCode,
+			Body:       io.NopCloser(strings.NewReader(`{"entries":{}}`)),
+		}, nil
+	}
+}
+
+func errorResponse(err error) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		return nil, err
+	}
+}
+
+func TestDeleteToken(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, loginResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodDelete {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					_, err := w.Write(data)
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+				require.NoError(t, tc.GetNewToken(context.Background()))
+
+				err := tc.DeleteToken(context.Background())
+				require.EqualError(t, err, "non 200 code returned 401")
+				hasToken := tc.HasToken()
+				require.True(t, hasToken)
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, loginResponseFile)
+				var tokenDetails *models.TokenDetails
+				err := json.Unmarshal(data, &tokenDetails)
+				require.NoError(t, err)
+				token := tokenDetails.Token.Token
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodDelete {
+						assert.Equal(t, tokensPath+"/"+token, r.URL.Path)
+						assert.Equal(t, token, r.Header.Get("X-F5-Auth-Token"))
+						_, err := w.Write([]byte(`{"token":"` + token + `"}`))
+						assert.NoError(t, err)
+						return
+					}
+					_, err := w.Write(data)
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+				require.NoError(t, tc.GetNewToken(context.Background()))
+
+				err = tc.DeleteToken(context.Background())
+				require.NoError(t, err)
+				hasToken := tc.HasToken()
+				require.False(t, hasToken)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
 func TestGetVirtualServers(t *testing.T) {
 	testCases := []struct {
 		desc     string
@@ -380,6 +772,96 @@ func TestGetVirtualServers(t *testing.T) {
 	}
 }
 
+func TestGetVirtualServersConditional(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Unchanged properties are reused",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, virtualServersResponseFile)
+				statsData := loadAPIResponseData(t, virtualServersStatsResponseFile)
+
+				// Setup test server
+				var propertyRequests, notModifiedResponses int
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var err error
+					if strings.HasSuffix(r.RequestURI, "stats") {
+						assert.Empty(t, r.Header.Get("If-None-Match"))
+						_, err = w.Write(statsData)
+					} else {
+						propertyRequests++
+						if r.Header.Get("If-None-Match") == `"1"` {
+							notModifiedResponses++
+							w.WriteHeader(http.StatusNotModified)
+							return
+						}
+						w.Header().Set("ETag", `"1"`)
+						_, err = w.Write(data)
+					}
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createConditionalTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.VirtualServers
+				combinedData := loadAPIResponseData(t, virtualServersCombinedFile)
+				err := json.Unmarshal(combinedData, &expected)
+				require.NoError(t, err)
+
+				for range 2 {
+					virtualServers, err := tc.GetVirtualServers(context.Background())
+					require.NoError(t, err)
+					require.Equal(t, expected, virtualServers)
+				}
+				require.Equal(t, 2, propertyRequests)
+				require.Equal(t, 1, notModifiedResponses)
+			},
+		},
+		{
+			desc: "ETag not supported",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, virtualServersResponseFile)
+				statsData := loadAPIResponseData(t, virtualServersStatsResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var err error
+					assert.Empty(t, r.Header.Get("If-None-Match"))
+					if strings.HasSuffix(r.RequestURI, "stats") {
+						_, err = w.Write(statsData)
+					} else {
+						_, err = w.Write(data)
+					}
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createConditionalTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.VirtualServers
+				combinedData := loadAPIResponseData(t, virtualServersCombinedFile)
+				err := json.Unmarshal(combinedData, &expected)
+				require.NoError(t, err)
+
+				for range 2 {
+					virtualServers, err := tc.GetVirtualServers(context.Background())
+					require.NoError(t, err)
+					require.Equal(t, expected, virtualServers)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
 func TestGetPools(t *testing.T) {
 	testCases := []struct {
 		desc     string
@@ -721,10 +1203,559 @@ func TestGetNodes(t *testing.T) {
 	}
 }
 
-func createTestClient(t *testing.T, baseEndpoint string) client {
+func TestGetClientSSLProfiles(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				profiles, err := tc.GetClientSSLProfiles(context.Background())
+				require.Nil(t, profiles)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, clientSSLProfilesResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, clientSSLProfilesStatsPath, r.URL.Path)
+					_, err := w.Write(data)
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.ClientSSLProfiles
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				profiles, err := tc.GetClientSSLProfiles(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, profiles)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestGetHTTPProfiles(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				profiles, err := tc.GetHTTPProfiles(context.Background())
+				require.Nil(t, profiles)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, httpProfilesResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, httpProfilesStatsPath, r.URL.Path)
+					_, err := w.Write(data)
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.HTTPProfiles
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				profiles, err := tc.GetHTTPProfiles(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, profiles)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestGetTrafficStats(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				stats, err := tc.GetTrafficStats(context.Background())
+				require.Nil(t, stats)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, trafficStatsResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, trafficStatsPath, r.URL.Path)
+					_, err := w.Write(data)
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.TrafficStats
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				stats, err := tc.GetTrafficStats(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, stats)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestGetTMMStats(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				stats, err := tc.GetTMMStats(context.Background())
+				require.Nil(t, stats)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, tmmStatsResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, tmmStatsPath, r.URL.Path)
+					_, err := w.Write(data)
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.TMMStats
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				stats, err := tc.GetTMMStats(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, stats)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestGetSystemStats(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				stats, err := tc.GetSystemStats(context.Background())
+				require.Nil(t, stats)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, systemStatsResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, systemStatsPath, r.URL.Path)
+					_, err := w.Write(data)
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.SystemStats
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				stats, err := tc.GetSystemStats(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, stats)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestGetInterfaces(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				stats, err := tc.GetInterfaces(context.Background())
+				require.Nil(t, stats)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, interfaceStatsResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, interfaceStatsPath, r.URL.Path)
+					_, err := w.Write(data)
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.InterfaceStats
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				stats, err := tc.GetInterfaces(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, stats)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestGetSNATPools(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				pools, err := tc.GetSNATPools(context.Background())
+				require.Nil(t, pools)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Not Found SNAT Pools",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				pools, err := tc.GetSNATPools(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, &models.SNATPools{}, pools)
+			},
+		},
+		{
+			desc: "Not Found SNAT Translation Stats",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, snatPoolsResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path == snatPoolsPath {
+						_, err := w.Write(data)
+						assert.NoError(t, err)
+						return
+					}
+					w.WriteHeader(http.StatusNotFound)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				pools, err := tc.GetSNATPools(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, &models.SNATPools{}, pools)
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				poolsData := loadAPIResponseData(t, snatPoolsResponseFile)
+				translationsData := loadAPIResponseData(t, snatTranslationsResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var err error
+					switch r.URL.Path {
+					case snatPoolsPath:
+						_, err = w.Write(poolsData)
+					case snatTranslationsStatsPath:
+						_, err = w.Write(translationsData)
+					default:
+						t.Errorf("unexpected request path %s", r.URL.Path)
+					}
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.SNATPools
+				combinedData := loadAPIResponseData(t, snatPoolsCombinedFile)
+				err := json.Unmarshal(combinedData, &expected)
+				require.NoError(t, err)
+
+				pools, err := tc.GetSNATPools(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, pools)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestGetFailoverStatus(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				status, err := tc.GetFailoverStatus(context.Background())
+				require.Nil(t, status)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				failoverData := loadAPIResponseData(t, failoverStatusResponseFile)
+				syncData := loadAPIResponseData(t, syncStatusResponseFile)
+				devicesData := loadAPIResponseData(t, devicesResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var err error
+					switch r.URL.Path {
+					case failoverStatusPath:
+						_, err = w.Write(failoverData)
+					case syncStatusPath:
+						_, err = w.Write(syncData)
+					case devicesPath:
+						_, err = w.Write(devicesData)
+					default:
+						t.Errorf("unexpected request path %s", r.URL.Path)
+					}
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				expected := &models.FailoverStatus{
+					Device:         "bigip-a.example.com",
+					FailoverStatus: "ACTIVE",
+					SyncStatus:     "In Sync",
+				}
+
+				status, err := tc.GetFailoverStatus(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, status)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestGetCustomStats(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				stats, err := tc.GetCustomStats(context.Background(), nodesStatsPath)
+				require.Nil(t, stats)
+				require.EqualError(t, err, "non 200 code returned 404")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, nodesStatsResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, nodesStatsPath, r.URL.Path)
+					_, err := w.Write(data)
+					assert.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.CustomStats
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				stats, err := tc.GetCustomStats(context.Background(), nodesStatsPath)
+				require.NoError(t, err)
+				require.Equal(t, expected, stats)
+				require.NotNil(t, stats.Entries["https://localhost/mgmt/tm/ltm/node/~Common~dev/stats"].NestedStats.Entries["serverside.maxConns"].Value)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func createTestClient(t *testing.T, baseEndpoint string) client {
+	t.Helper()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = baseEndpoint
+
+	testClient, err := newClient(context.Background(), cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(), zap.NewNop())
+	require.NoError(t, err)
+	return testClient
+}
+
+func createConditionalTestClient(t *testing.T, baseEndpoint string) client {
 	t.Helper()
 	cfg := createDefaultConfig().(*Config)
 	cfg.Endpoint = baseEndpoint
+	cfg.UseConditionalRequests = true
 
 	testClient, err := newClient(context.Background(), cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(), zap.NewNop())
 	require.NoError(t, err)
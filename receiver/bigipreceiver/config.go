@@ -4,9 +4,14 @@
 package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver"
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -18,9 +23,29 @@ import (
 
 // Predefined error responses for configuration validation failures
 var (
-	errMissingUsername = errors.New(`"username" not specified in config`)
-	errMissingPassword = errors.New(`"password" not specified in config`)
-	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+	errMissingUsername          = errors.New(`"username" not specified in config`)
+	errMissingPassword          = errors.New(`"password" not specified in config`)
+	errMissingDeviceEndpoint    = errors.New(`"endpoint" not specified for device`)
+	errInvalidEndpoint          = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+	errMissingCustomMetricName  = errors.New(`"name" not specified for custom metric`)
+	errInvalidCustomMetricPath  = errors.New(`"path" for custom metric must be an absolute iControl REST path`)
+	errMissingCustomMetricField = errors.New(`"field" not specified for custom metric`)
+	errNegativeLoginRetries     = errors.New(`"login_retries" must not be negative`)
+	errNegativeLoginBackoff     = errors.New(`"login_retry_backoff" must not be negative`)
+	errNegativeRequestRetries   = errors.New(`"max_request_retries" must not be negative`)
+	errNegativeRequestBackoff   = errors.New(`"request_retry_backoff" must not be negative`)
+	errNegativeLoginTimeout     = errors.New(`"login_timeout" must not be negative`)
+	errNegativeRefreshBuffer    = errors.New(`"token_refresh_buffer" must not be negative`)
+	errInvalidPartition         = errors.New(`"partitions" must only contain non-empty partition names without "/"`)
+	errInvalidPinnedCert        = errors.New(`"pinned_cert_sha256" must be a hex encoded SHA-256 fingerprint`)
+	errPinnedCertWithCA         = errors.New(`"pinned_cert_sha256" cannot be combined with "ca_file", "ca_pem" or "insecure_skip_verify"`)
+)
+
+const (
+	// customMetricTypeGauge emits the custom metric as a gauge
+	customMetricTypeGauge = "gauge"
+	// customMetricTypeCounter emits the custom metric as a monotonic cumulative sum
+	customMetricTypeCounter = "counter"
 )
 
 const defaultEndpoint = "https://localhost:443"
@@ -32,24 +57,220 @@ type Config struct {
 	Username                       string              `mapstructure:"username"`
 	Password                       configopaque.String `mapstructure:"password"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	// Endpoints lists the devices to scrape instead of the single device at Endpoint
+	Endpoints []DeviceConfig `mapstructure:"endpoints"`
+	// CustomMetrics maps arbitrary iControl REST statistics to named metrics
+	CustomMetrics []CustomMetricConfig `mapstructure:"custom_metrics"`
+	// LoginTimeout is the timeout of token requests, it overrides the general request timeout for them when set
+	LoginTimeout time.Duration `mapstructure:"login_timeout"`
+	// LoginRetries is the number of times a failed token request is retried within a single scrape
+	LoginRetries int `mapstructure:"login_retries"`
+	// LoginRetryBackoff is the time to wait between token request attempts
+	LoginRetryBackoff time.Duration `mapstructure:"login_retry_backoff"`
+	// MaxRequestRetries is the number of times a request failing with a 5xx status code or a connection reset is retried
+	MaxRequestRetries int `mapstructure:"max_request_retries"`
+	// RequestRetryBackoff is the time to wait between request attempts
+	RequestRetryBackoff time.Duration `mapstructure:"request_retry_backoff"`
+	// TokenRefreshBuffer is how long before its expiration the auth token is replaced by a new one
+	TokenRefreshBuffer time.Duration `mapstructure:"token_refresh_buffer"`
+	// LogoutOnShutdown controls whether the auth token is deleted from the Big-IP when the receiver shuts down
+	LogoutOnShutdown bool `mapstructure:"logout_on_shutdown"`
+	// UseConditionalRequests controls whether slow-changing inventory endpoints are requested with the ETag of their last response
+	UseConditionalRequests bool `mapstructure:"use_conditional_requests"`
+	// PinnedCertSHA256 is the SHA-256 fingerprint of the only server certificate accepted, whether or not its chain can be verified
+	PinnedCertSHA256 string `mapstructure:"pinned_cert_sha256"`
+	// Partitions limits scraping to objects of the given administrative partitions, all partitions are scraped when empty
+	Partitions []string `mapstructure:"partitions"`
+	// IncludeDisabled controls whether administratively disabled objects are scraped
+	IncludeDisabled bool `mapstructure:"include_disabled"`
+	// IncludeNameFilter is a regular expression that object names must match to be scraped
+	IncludeNameFilter string `mapstructure:"include_name_filter"`
+	// ExcludeNameFilter is a regular expression excluding matching object names from scraping, it wins over IncludeNameFilter
+	ExcludeNameFilter string `mapstructure:"exclude_name_filter"`
+}
+
+// DeviceConfig defines a Big-IP device scraped by the receiver
+type DeviceConfig struct {
+	// Endpoint is the URL of the device, e.g. https://bigip-1:443
+	Endpoint string `mapstructure:"endpoint"`
+	// Username is the user of the device, the top level username is used when empty
+	Username string `mapstructure:"username"`
+	// Password is the password of the device, the top level password is used when empty
+	Password configopaque.String `mapstructure:"password"`
+}
+
+// CustomMetricConfig defines a metric read from an arbitrary iControl REST statistics endpoint
+type CustomMetricConfig struct {
+	// Name is the name of the emitted metric
+	Name string `mapstructure:"name"`
+	// Description is the description of the emitted metric
+	Description string `mapstructure:"description"`
+	// Unit is the unit of the emitted metric
+	Unit string `mapstructure:"unit"`
+	// Path is the iControl REST statistics path to query, e.g. /mgmt/tm/ltm/virtual/stats
+	Path string `mapstructure:"path"`
+	// Field is the name of the nested statistics entry holding the value, e.g. clientside.bitsIn
+	Field string `mapstructure:"field"`
+	// Type is either "gauge" or "counter"
+	Type string `mapstructure:"type"`
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
 func (cfg *Config) Validate() error {
+	var err error
+	if len(cfg.Endpoints) == 0 {
+		if cfg.Username == "" {
+			err = multierr.Append(err, errMissingUsername)
+		}
+
+		if cfg.Password == "" {
+			err = multierr.Append(err, errMissingPassword)
+		}
+
+		_, parseErr := url.Parse(cfg.Endpoint)
+		if parseErr != nil {
+			wrappedErr := fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr)
+			err = multierr.Append(err, wrappedErr)
+		}
+	}
+
+	endpoints := make(map[string]struct{}, len(cfg.Endpoints))
+	for i := range cfg.Endpoints {
+		device := cfg.deviceConfig(&cfg.Endpoints[i])
+		err = multierr.Append(err, device.validateDevice())
+		if _, ok := endpoints[device.Endpoint]; ok {
+			err = multierr.Append(err, fmt.Errorf("device %q is defined more than once", device.Endpoint))
+		}
+		endpoints[device.Endpoint] = struct{}{}
+	}
+
+	if cfg.LoginTimeout < 0 {
+		err = multierr.Append(err, errNegativeLoginTimeout)
+	}
+
+	if cfg.TokenRefreshBuffer < 0 {
+		err = multierr.Append(err, errNegativeRefreshBuffer)
+	}
+
+	if cfg.LoginRetries < 0 {
+		err = multierr.Append(err, errNegativeLoginRetries)
+	}
+
+	if cfg.LoginRetryBackoff < 0 {
+		err = multierr.Append(err, errNegativeLoginBackoff)
+	}
+
+	if cfg.MaxRequestRetries < 0 {
+		err = multierr.Append(err, errNegativeRequestRetries)
+	}
+
+	if cfg.RequestRetryBackoff < 0 {
+		err = multierr.Append(err, errNegativeRequestBackoff)
+	}
+
+	if cfg.PinnedCertSHA256 != "" {
+		if _, decodeErr := parseCertFingerprint(cfg.PinnedCertSHA256); decodeErr != nil {
+			err = multierr.Append(err, errInvalidPinnedCert)
+		}
+		tlsSetting := cfg.TLSSetting
+		if tlsSetting.CAFile != "" || tlsSetting.CAPem != "" || tlsSetting.InsecureSkipVerify {
+			err = multierr.Append(err, errPinnedCertWithCA)
+		}
+	}
+
+	for _, partition := range cfg.Partitions {
+		if partition == "" || strings.Contains(partition, "/") {
+			err = multierr.Append(err, errInvalidPartition)
+			break
+		}
+	}
+
+	if _, compileErr := regexp.Compile(cfg.IncludeNameFilter); compileErr != nil {
+		err = multierr.Append(err, fmt.Errorf(`"include_name_filter" is not a valid regular expression: %w`, compileErr))
+	}
+
+	if _, compileErr := regexp.Compile(cfg.ExcludeNameFilter); compileErr != nil {
+		err = multierr.Append(err, fmt.Errorf(`"exclude_name_filter" is not a valid regular expression: %w`, compileErr))
+	}
+
+	names := make(map[string]struct{}, len(cfg.CustomMetrics))
+	for _, customMetric := range cfg.CustomMetrics {
+		err = multierr.Append(err, customMetric.validate())
+		if _, ok := names[customMetric.Name]; ok {
+			err = multierr.Append(err, fmt.Errorf("custom metric %q is defined more than once", customMetric.Name))
+		}
+		names[customMetric.Name] = struct{}{}
+	}
+
+	return err
+}
+
+// deviceConfig returns a copy of the config pointing to the passed in device, with its credentials applied
+func (cfg *Config) deviceConfig(device *DeviceConfig) *Config {
+	deviceCfg := *cfg
+	deviceCfg.Endpoints = nil
+	deviceCfg.Endpoint = device.Endpoint
+	if device.Username != "" {
+		deviceCfg.Username = device.Username
+	}
+	if device.Password != "" {
+		deviceCfg.Password = device.Password
+	}
+	return &deviceCfg
+}
+
+// validateDevice checks the endpoint and credentials of a config returned by deviceConfig
+func (cfg *Config) validateDevice() error {
+	if cfg.Endpoint == "" {
+		return errMissingDeviceEndpoint
+	}
+
 	var err error
 	if cfg.Username == "" {
-		err = multierr.Append(err, errMissingUsername)
+		err = multierr.Append(err, fmt.Errorf("device %q: %w", cfg.Endpoint, errMissingUsername))
 	}
 
 	if cfg.Password == "" {
-		err = multierr.Append(err, errMissingPassword)
+		err = multierr.Append(err, fmt.Errorf("device %q: %w", cfg.Endpoint, errMissingPassword))
+	}
+
+	if _, parseErr := url.Parse(cfg.Endpoint); parseErr != nil {
+		err = multierr.Append(err, fmt.Errorf("device %q: %s: %w", cfg.Endpoint, errInvalidEndpoint.Error(), parseErr))
+	}
+
+	return err
+}
+
+// validate checks a custom metric definition for missing or invalid fields
+func (cfg *CustomMetricConfig) validate() error {
+	var err error
+	if cfg.Name == "" {
+		err = multierr.Append(err, errMissingCustomMetricName)
 	}
 
-	_, parseErr := url.Parse(cfg.Endpoint)
-	if parseErr != nil {
-		wrappedErr := fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr)
-		err = multierr.Append(err, wrappedErr)
+	if !strings.HasPrefix(cfg.Path, "/") {
+		err = multierr.Append(err, errInvalidCustomMetricPath)
+	}
+
+	if cfg.Field == "" {
+		err = multierr.Append(err, errMissingCustomMetricField)
+	}
+
+	if cfg.Type != customMetricTypeGauge && cfg.Type != customMetricTypeCounter {
+		err = multierr.Append(err, fmt.Errorf(`"type" for custom metric %q must be one of %q or %q`, cfg.Name, customMetricTypeGauge, customMetricTypeCounter))
 	}
 
 	return err
 }
+
+// parseCertFingerprint decodes a hex encoded SHA-256 fingerprint, the bytes may be separated by colons
+func parseCertFingerprint(fingerprint string) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != sha256.Size {
+		return nil, fmt.Errorf("fingerprint has %d bytes instead of %d", len(decoded), sha256.Size)
+	}
+	return decoded, nil
+}
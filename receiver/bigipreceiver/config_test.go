@@ -4,9 +4,11 @@
 package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -22,6 +24,10 @@ func TestValidate(t *testing.T) {
 	clientConfig := confighttp.NewDefaultClientConfig()
 	clientConfig.Endpoint = defaultEndpoint
 
+	clientConfigWithCA := confighttp.NewDefaultClientConfig()
+	clientConfigWithCA.Endpoint = defaultEndpoint
+	clientConfigWithCA.TLSSetting.CAFile = "/path/to/ca.pem"
+
 	clientConfigInvalid := confighttp.NewDefaultClientConfig()
 	clientConfigInvalid.Endpoint = "invalid://endpoint:  12efg"
 	defaultConfig := createDefaultConfig().(*Config)
@@ -104,6 +110,166 @@ func TestValidate(t *testing.T) {
 			cfg:         defaultConfig,
 			expectedErr: nil,
 		},
+		{
+			desc: "invalid custom metrics",
+			cfg: &Config{
+				Username:         "otelu",
+				Password:         "otelp",
+				ClientConfig:     clientConfig,
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				CustomMetrics: []CustomMetricConfig{
+					{Name: "bigip.custom", Path: "mgmt/tm/sys/stats", Type: "histogram"},
+					{Name: "bigip.custom", Path: "/mgmt/tm/sys/stats", Field: "value", Type: customMetricTypeGauge},
+				},
+			},
+			expectedErr: multierr.Combine(
+				errInvalidCustomMetricPath,
+				errMissingCustomMetricField,
+				errors.New(`"type" for custom metric "bigip.custom" must be one of "gauge" or "counter"`),
+				errors.New(`custom metric "bigip.custom" is defined more than once`),
+			),
+		},
+		{
+			desc: "negative login timeout, retries and backoff",
+			cfg: &Config{
+				Username:          "otelu",
+				Password:          "otelp",
+				ClientConfig:      clientConfig,
+				ControllerConfig:  scraperhelper.NewDefaultControllerConfig(),
+				LoginTimeout:      -time.Second,
+				LoginRetries:      -1,
+				LoginRetryBackoff: -time.Second,
+			},
+			expectedErr: multierr.Combine(
+				errNegativeLoginTimeout,
+				errNegativeLoginRetries,
+				errNegativeLoginBackoff,
+			),
+		},
+		{
+			desc: "negative token refresh buffer",
+			cfg: &Config{
+				Username:           "otelu",
+				Password:           "otelp",
+				ClientConfig:       clientConfig,
+				ControllerConfig:   scraperhelper.NewDefaultControllerConfig(),
+				TokenRefreshBuffer: -time.Minute,
+			},
+			expectedErr: errNegativeRefreshBuffer,
+		},
+		{
+			desc: "negative request retries and backoff",
+			cfg: &Config{
+				Username:            "otelu",
+				Password:            "otelp",
+				ClientConfig:        clientConfig,
+				ControllerConfig:    scraperhelper.NewDefaultControllerConfig(),
+				MaxRequestRetries:   -1,
+				RequestRetryBackoff: -time.Second,
+			},
+			expectedErr: multierr.Combine(
+				errNegativeRequestRetries,
+				errNegativeRequestBackoff,
+			),
+		},
+		{
+			desc: "invalid partitions",
+			cfg: &Config{
+				Username:         "otelu",
+				Password:         "otelp",
+				ClientConfig:     clientConfig,
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Partitions:       []string{"Common", "/stage"},
+			},
+			expectedErr: errInvalidPartition,
+		},
+		{
+			desc: "invalid pinned cert",
+			cfg: &Config{
+				Username:         "otelu",
+				Password:         "otelp",
+				ClientConfig:     clientConfigWithCA,
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				PinnedCertSHA256: "not a fingerprint",
+			},
+			expectedErr: multierr.Combine(
+				errInvalidPinnedCert,
+				errPinnedCertWithCA,
+			),
+		},
+		{
+			desc: "valid pinned cert",
+			cfg: &Config{
+				Username:         "otelu",
+				Password:         "otelp",
+				ClientConfig:     clientConfig,
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				PinnedCertSHA256: "9F:86:D0:81:88:4C:7D:65:9A:2F:EA:A0:C5:5A:D0:15:A3:BF:4F:1B:2B:0B:82:2C:D1:5D:6C:15:B0:F0:0A:08",
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "valid endpoints with top level credentials",
+			cfg: &Config{
+				Username:         "otelu",
+				Password:         "otelp",
+				ClientConfig:     clientConfig,
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Endpoints: []DeviceConfig{
+					{Endpoint: "https://bigip-a:443"},
+					{Endpoint: "https://bigip-b:443", Username: "otelb", Password: "otelpb"},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "invalid endpoints",
+			cfg: &Config{
+				Password:         "otelp",
+				ClientConfig:     clientConfig,
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Endpoints: []DeviceConfig{
+					{Endpoint: "https://bigip-a:443"},
+					{Endpoint: "https://bigip-b:443", Username: "otelb"},
+					{Endpoint: "https://bigip-b:443", Username: "otelb"},
+					{Username: "otelc"},
+				},
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("device %q: %w", "https://bigip-a:443", errMissingUsername),
+				errors.New(`device "https://bigip-b:443" is defined more than once`),
+				errMissingDeviceEndpoint,
+			),
+		},
+		{
+			desc: "invalid name filters",
+			cfg: &Config{
+				Username:          "otelu",
+				Password:          "otelp",
+				ClientConfig:      clientConfig,
+				ControllerConfig:  scraperhelper.NewDefaultControllerConfig(),
+				IncludeNameFilter: "^/Common/(",
+				ExcludeNameFilter: "[",
+			},
+			expectedErr: multierr.Combine(
+				errors.New(`"include_name_filter" is not a valid regular expression: error parsing regexp: missing closing ): `+"`^/Common/(`"),
+				errors.New(`"exclude_name_filter" is not a valid regular expression: error parsing regexp: missing closing ]: `+"`[`"),
+			),
+		},
+		{
+			desc: "valid custom metrics",
+			cfg: &Config{
+				Username:         "otelu",
+				Password:         "otelp",
+				ClientConfig:     clientConfig,
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				CustomMetrics: []CustomMetricConfig{
+					{Name: "bigip.custom.gauge", Path: "/mgmt/tm/sys/stats", Field: "curConns", Type: customMetricTypeGauge},
+					{Name: "bigip.custom.counter", Path: "/mgmt/tm/sys/stats", Field: "totConns", Type: customMetricTypeCounter},
+				},
+			},
+			expectedErr: nil,
+		},
 	}
 
 	for _, tc := range testCases {
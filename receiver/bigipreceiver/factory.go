@@ -39,6 +39,13 @@ func createDefaultConfig() component.Config {
 		},
 		ClientConfig:         clientConfig,
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		LoginRetries:         2,
+		LoginRetryBackoff:    time.Second,
+		MaxRequestRetries:    2,
+		RequestRetryBackoff:  500 * time.Millisecond,
+		TokenRefreshBuffer:   time.Minute,
+		LogoutOnShutdown:     true,
+		IncludeDisabled:      true,
 	}
 }
 
@@ -50,7 +57,7 @@ func createMetricsReceiver(_ context.Context, params receiver.Settings, rConf co
 	}
 
 	bigipScraper := newScraper(params.Logger, cfg, params)
-	s, err := scraper.NewMetrics(bigipScraper.scrape, scraper.WithStart(bigipScraper.start))
+	s, err := scraper.NewMetrics(bigipScraper.scrape, scraper.WithStart(bigipScraper.start), scraper.WithShutdown(bigipScraper.shutdown))
 	if err != nil {
 		return nil, err
 	}
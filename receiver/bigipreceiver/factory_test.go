@@ -44,6 +44,13 @@ func TestNewFactory(t *testing.T) {
 					},
 					ClientConfig:         clientConfig,
 					MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+					LoginRetries:         2,
+					LoginRetryBackoff:    time.Second,
+					MaxRequestRetries:    2,
+					RequestRetryBackoff:  500 * time.Millisecond,
+					TokenRefreshBuffer:   time.Minute,
+					LogoutOnShutdown:     true,
+					IncludeDisabled:      true,
 				}
 
 				require.Equal(t, expectedCfg, factory.CreateDefaultConfig())
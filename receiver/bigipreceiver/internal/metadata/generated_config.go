@@ -28,37 +28,88 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for bigip metrics.
 type MetricsConfig struct {
-	BigipNodeAvailability             MetricConfig `mapstructure:"bigip.node.availability"`
-	BigipNodeConnectionCount          MetricConfig `mapstructure:"bigip.node.connection.count"`
-	BigipNodeDataTransmitted          MetricConfig `mapstructure:"bigip.node.data.transmitted"`
-	BigipNodeEnabled                  MetricConfig `mapstructure:"bigip.node.enabled"`
-	BigipNodePacketCount              MetricConfig `mapstructure:"bigip.node.packet.count"`
-	BigipNodeRequestCount             MetricConfig `mapstructure:"bigip.node.request.count"`
-	BigipNodeSessionCount             MetricConfig `mapstructure:"bigip.node.session.count"`
-	BigipPoolAvailability             MetricConfig `mapstructure:"bigip.pool.availability"`
-	BigipPoolConnectionCount          MetricConfig `mapstructure:"bigip.pool.connection.count"`
-	BigipPoolDataTransmitted          MetricConfig `mapstructure:"bigip.pool.data.transmitted"`
-	BigipPoolEnabled                  MetricConfig `mapstructure:"bigip.pool.enabled"`
-	BigipPoolMemberCount              MetricConfig `mapstructure:"bigip.pool.member.count"`
-	BigipPoolPacketCount              MetricConfig `mapstructure:"bigip.pool.packet.count"`
-	BigipPoolRequestCount             MetricConfig `mapstructure:"bigip.pool.request.count"`
-	BigipPoolMemberAvailability       MetricConfig `mapstructure:"bigip.pool_member.availability"`
-	BigipPoolMemberConnectionCount    MetricConfig `mapstructure:"bigip.pool_member.connection.count"`
-	BigipPoolMemberDataTransmitted    MetricConfig `mapstructure:"bigip.pool_member.data.transmitted"`
-	BigipPoolMemberEnabled            MetricConfig `mapstructure:"bigip.pool_member.enabled"`
-	BigipPoolMemberPacketCount        MetricConfig `mapstructure:"bigip.pool_member.packet.count"`
-	BigipPoolMemberRequestCount       MetricConfig `mapstructure:"bigip.pool_member.request.count"`
-	BigipPoolMemberSessionCount       MetricConfig `mapstructure:"bigip.pool_member.session.count"`
-	BigipVirtualServerAvailability    MetricConfig `mapstructure:"bigip.virtual_server.availability"`
-	BigipVirtualServerConnectionCount MetricConfig `mapstructure:"bigip.virtual_server.connection.count"`
-	BigipVirtualServerDataTransmitted MetricConfig `mapstructure:"bigip.virtual_server.data.transmitted"`
-	BigipVirtualServerEnabled         MetricConfig `mapstructure:"bigip.virtual_server.enabled"`
-	BigipVirtualServerPacketCount     MetricConfig `mapstructure:"bigip.virtual_server.packet.count"`
-	BigipVirtualServerRequestCount    MetricConfig `mapstructure:"bigip.virtual_server.request.count"`
+	BigipCmFailoverActive                MetricConfig `mapstructure:"bigip.cm.failover.active"`
+	BigipCmSyncStatus                    MetricConfig `mapstructure:"bigip.cm.sync.status"`
+	BigipDeviceConnectionCount           MetricConfig `mapstructure:"bigip.device.connection.count"`
+	BigipDeviceDataTransmitted           MetricConfig `mapstructure:"bigip.device.data.transmitted"`
+	BigipDevicePacketCount               MetricConfig `mapstructure:"bigip.device.packet.count"`
+	BigipHTTPResponses                   MetricConfig `mapstructure:"bigip.http.responses"`
+	BigipNetInterfaceBitsReceived        MetricConfig `mapstructure:"bigip.net.interface.bits.received"`
+	BigipNetInterfaceBitsSent            MetricConfig `mapstructure:"bigip.net.interface.bits.sent"`
+	BigipNetInterfaceDropsReceived       MetricConfig `mapstructure:"bigip.net.interface.drops.received"`
+	BigipNetInterfaceDropsSent           MetricConfig `mapstructure:"bigip.net.interface.drops.sent"`
+	BigipNodeAvailability                MetricConfig `mapstructure:"bigip.node.availability"`
+	BigipNodeConnectionCount             MetricConfig `mapstructure:"bigip.node.connection.count"`
+	BigipNodeDataTransmitted             MetricConfig `mapstructure:"bigip.node.data.transmitted"`
+	BigipNodeEnabled                     MetricConfig `mapstructure:"bigip.node.enabled"`
+	BigipNodePacketCount                 MetricConfig `mapstructure:"bigip.node.packet.count"`
+	BigipNodeRequestCount                MetricConfig `mapstructure:"bigip.node.request.count"`
+	BigipNodeSessionCount                MetricConfig `mapstructure:"bigip.node.session.count"`
+	BigipPoolAvailability                MetricConfig `mapstructure:"bigip.pool.availability"`
+	BigipPoolConnectionCount             MetricConfig `mapstructure:"bigip.pool.connection.count"`
+	BigipPoolDataTransmitted             MetricConfig `mapstructure:"bigip.pool.data.transmitted"`
+	BigipPoolEnabled                     MetricConfig `mapstructure:"bigip.pool.enabled"`
+	BigipPoolMemberCount                 MetricConfig `mapstructure:"bigip.pool.member.count"`
+	BigipPoolPacketCount                 MetricConfig `mapstructure:"bigip.pool.packet.count"`
+	BigipPoolRequestCount                MetricConfig `mapstructure:"bigip.pool.request.count"`
+	BigipPoolMemberAvailability          MetricConfig `mapstructure:"bigip.pool_member.availability"`
+	BigipPoolMemberConnectionCount       MetricConfig `mapstructure:"bigip.pool_member.connection.count"`
+	BigipPoolMemberDataTransmitted       MetricConfig `mapstructure:"bigip.pool_member.data.transmitted"`
+	BigipPoolMemberEnabled               MetricConfig `mapstructure:"bigip.pool_member.enabled"`
+	BigipPoolMemberMonitorStatus         MetricConfig `mapstructure:"bigip.pool_member.monitor.status"`
+	BigipPoolMemberPacketCount           MetricConfig `mapstructure:"bigip.pool_member.packet.count"`
+	BigipPoolMemberRequestCount          MetricConfig `mapstructure:"bigip.pool_member.request.count"`
+	BigipPoolMemberSessionCount          MetricConfig `mapstructure:"bigip.pool_member.session.count"`
+	BigipSnatpoolConnections             MetricConfig `mapstructure:"bigip.snatpool.connections"`
+	BigipSnatpoolTranslationAddressCount MetricConfig `mapstructure:"bigip.snatpool.translation_address.count"`
+	BigipSslProfileCipherCount           MetricConfig `mapstructure:"bigip.ssl.profile.cipher.count"`
+	BigipSslProfileConnectionsCurrent    MetricConfig `mapstructure:"bigip.ssl.profile.connections.current"`
+	BigipSslProfileHandshakes            MetricConfig `mapstructure:"bigip.ssl.profile.handshakes"`
+	BigipSslProfileKeyExchangeCount      MetricConfig `mapstructure:"bigip.ssl.profile.key_exchange.count"`
+	BigipSslProfileProtocolCount         MetricConfig `mapstructure:"bigip.ssl.profile.protocol.count"`
+	BigipSystemMemoryTotal               MetricConfig `mapstructure:"bigip.system.memory.total"`
+	BigipSystemMemoryUsed                MetricConfig `mapstructure:"bigip.system.memory.used"`
+	BigipSystemTmmCPUUtilization         MetricConfig `mapstructure:"bigip.system.tmm.cpu.utilization"`
+	BigipVirtualServerAvailability       MetricConfig `mapstructure:"bigip.virtual_server.availability"`
+	BigipVirtualServerConnectionCount    MetricConfig `mapstructure:"bigip.virtual_server.connection.count"`
+	BigipVirtualServerDataTransmitted    MetricConfig `mapstructure:"bigip.virtual_server.data.transmitted"`
+	BigipVirtualServerEnabled            MetricConfig `mapstructure:"bigip.virtual_server.enabled"`
+	BigipVirtualServerPacketCount        MetricConfig `mapstructure:"bigip.virtual_server.packet.count"`
+	BigipVirtualServerRequestCount       MetricConfig `mapstructure:"bigip.virtual_server.request.count"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		BigipCmFailoverActive: MetricConfig{
+			Enabled: false,
+		},
+		BigipCmSyncStatus: MetricConfig{
+			Enabled: false,
+		},
+		BigipDeviceConnectionCount: MetricConfig{
+			Enabled: false,
+		},
+		BigipDeviceDataTransmitted: MetricConfig{
+			Enabled: false,
+		},
+		BigipDevicePacketCount: MetricConfig{
+			Enabled: false,
+		},
+		BigipHTTPResponses: MetricConfig{
+			Enabled: false,
+		},
+		BigipNetInterfaceBitsReceived: MetricConfig{
+			Enabled: false,
+		},
+		BigipNetInterfaceBitsSent: MetricConfig{
+			Enabled: false,
+		},
+		BigipNetInterfaceDropsReceived: MetricConfig{
+			Enabled: false,
+		},
+		BigipNetInterfaceDropsSent: MetricConfig{
+			Enabled: false,
+		},
 		BigipNodeAvailability: MetricConfig{
 			Enabled: true,
 		},
@@ -113,6 +164,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		BigipPoolMemberEnabled: MetricConfig{
 			Enabled: true,
 		},
+		BigipPoolMemberMonitorStatus: MetricConfig{
+			Enabled: false,
+		},
 		BigipPoolMemberPacketCount: MetricConfig{
 			Enabled: true,
 		},
@@ -122,6 +176,36 @@ func DefaultMetricsConfig() MetricsConfig {
 		BigipPoolMemberSessionCount: MetricConfig{
 			Enabled: true,
 		},
+		BigipSnatpoolConnections: MetricConfig{
+			Enabled: false,
+		},
+		BigipSnatpoolTranslationAddressCount: MetricConfig{
+			Enabled: false,
+		},
+		BigipSslProfileCipherCount: MetricConfig{
+			Enabled: false,
+		},
+		BigipSslProfileConnectionsCurrent: MetricConfig{
+			Enabled: false,
+		},
+		BigipSslProfileHandshakes: MetricConfig{
+			Enabled: false,
+		},
+		BigipSslProfileKeyExchangeCount: MetricConfig{
+			Enabled: false,
+		},
+		BigipSslProfileProtocolCount: MetricConfig{
+			Enabled: false,
+		},
+		BigipSystemMemoryTotal: MetricConfig{
+			Enabled: false,
+		},
+		BigipSystemMemoryUsed: MetricConfig{
+			Enabled: false,
+		},
+		BigipSystemTmmCPUUtilization: MetricConfig{
+			Enabled: false,
+		},
 		BigipVirtualServerAvailability: MetricConfig{
 			Enabled: true,
 		},
@@ -171,17 +255,26 @@ func (rac *ResourceAttributeConfig) Unmarshal(parser *confmap.Conf) error {
 
 // ResourceAttributesConfig provides config for bigip resource attributes.
 type ResourceAttributesConfig struct {
+	BigipDeviceEndpoint           ResourceAttributeConfig `mapstructure:"bigip.device.endpoint"`
+	BigipHTTPProfileName          ResourceAttributeConfig `mapstructure:"bigip.http.profile.name"`
 	BigipNodeIPAddress            ResourceAttributeConfig `mapstructure:"bigip.node.ip_address"`
 	BigipNodeName                 ResourceAttributeConfig `mapstructure:"bigip.node.name"`
 	BigipPoolName                 ResourceAttributeConfig `mapstructure:"bigip.pool.name"`
 	BigipPoolMemberIPAddress      ResourceAttributeConfig `mapstructure:"bigip.pool_member.ip_address"`
 	BigipPoolMemberName           ResourceAttributeConfig `mapstructure:"bigip.pool_member.name"`
+	BigipSslProfileName           ResourceAttributeConfig `mapstructure:"bigip.ssl.profile.name"`
 	BigipVirtualServerDestination ResourceAttributeConfig `mapstructure:"bigip.virtual_server.destination"`
 	BigipVirtualServerName        ResourceAttributeConfig `mapstructure:"bigip.virtual_server.name"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 	return ResourceAttributesConfig{
+		BigipDeviceEndpoint: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		BigipHTTPProfileName: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		BigipNodeIPAddress: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -197,6 +290,9 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		BigipPoolMemberName: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		BigipSslProfileName: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		BigipVirtualServerDestination: ResourceAttributeConfig{
 			Enabled: true,
 		},
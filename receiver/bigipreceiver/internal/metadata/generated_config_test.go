@@ -25,40 +25,64 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					BigipNodeAvailability:             MetricConfig{Enabled: true},
-					BigipNodeConnectionCount:          MetricConfig{Enabled: true},
-					BigipNodeDataTransmitted:          MetricConfig{Enabled: true},
-					BigipNodeEnabled:                  MetricConfig{Enabled: true},
-					BigipNodePacketCount:              MetricConfig{Enabled: true},
-					BigipNodeRequestCount:             MetricConfig{Enabled: true},
-					BigipNodeSessionCount:             MetricConfig{Enabled: true},
-					BigipPoolAvailability:             MetricConfig{Enabled: true},
-					BigipPoolConnectionCount:          MetricConfig{Enabled: true},
-					BigipPoolDataTransmitted:          MetricConfig{Enabled: true},
-					BigipPoolEnabled:                  MetricConfig{Enabled: true},
-					BigipPoolMemberCount:              MetricConfig{Enabled: true},
-					BigipPoolPacketCount:              MetricConfig{Enabled: true},
-					BigipPoolRequestCount:             MetricConfig{Enabled: true},
-					BigipPoolMemberAvailability:       MetricConfig{Enabled: true},
-					BigipPoolMemberConnectionCount:    MetricConfig{Enabled: true},
-					BigipPoolMemberDataTransmitted:    MetricConfig{Enabled: true},
-					BigipPoolMemberEnabled:            MetricConfig{Enabled: true},
-					BigipPoolMemberPacketCount:        MetricConfig{Enabled: true},
-					BigipPoolMemberRequestCount:       MetricConfig{Enabled: true},
-					BigipPoolMemberSessionCount:       MetricConfig{Enabled: true},
-					BigipVirtualServerAvailability:    MetricConfig{Enabled: true},
-					BigipVirtualServerConnectionCount: MetricConfig{Enabled: true},
-					BigipVirtualServerDataTransmitted: MetricConfig{Enabled: true},
-					BigipVirtualServerEnabled:         MetricConfig{Enabled: true},
-					BigipVirtualServerPacketCount:     MetricConfig{Enabled: true},
-					BigipVirtualServerRequestCount:    MetricConfig{Enabled: true},
+					BigipCmFailoverActive:                MetricConfig{Enabled: true},
+					BigipCmSyncStatus:                    MetricConfig{Enabled: true},
+					BigipDeviceConnectionCount:           MetricConfig{Enabled: true},
+					BigipDeviceDataTransmitted:           MetricConfig{Enabled: true},
+					BigipDevicePacketCount:               MetricConfig{Enabled: true},
+					BigipHTTPResponses:                   MetricConfig{Enabled: true},
+					BigipNetInterfaceBitsReceived:        MetricConfig{Enabled: true},
+					BigipNetInterfaceBitsSent:            MetricConfig{Enabled: true},
+					BigipNetInterfaceDropsReceived:       MetricConfig{Enabled: true},
+					BigipNetInterfaceDropsSent:           MetricConfig{Enabled: true},
+					BigipNodeAvailability:                MetricConfig{Enabled: true},
+					BigipNodeConnectionCount:             MetricConfig{Enabled: true},
+					BigipNodeDataTransmitted:             MetricConfig{Enabled: true},
+					BigipNodeEnabled:                     MetricConfig{Enabled: true},
+					BigipNodePacketCount:                 MetricConfig{Enabled: true},
+					BigipNodeRequestCount:                MetricConfig{Enabled: true},
+					BigipNodeSessionCount:                MetricConfig{Enabled: true},
+					BigipPoolAvailability:                MetricConfig{Enabled: true},
+					BigipPoolConnectionCount:             MetricConfig{Enabled: true},
+					BigipPoolDataTransmitted:             MetricConfig{Enabled: true},
+					BigipPoolEnabled:                     MetricConfig{Enabled: true},
+					BigipPoolMemberCount:                 MetricConfig{Enabled: true},
+					BigipPoolPacketCount:                 MetricConfig{Enabled: true},
+					BigipPoolRequestCount:                MetricConfig{Enabled: true},
+					BigipPoolMemberAvailability:          MetricConfig{Enabled: true},
+					BigipPoolMemberConnectionCount:       MetricConfig{Enabled: true},
+					BigipPoolMemberDataTransmitted:       MetricConfig{Enabled: true},
+					BigipPoolMemberEnabled:               MetricConfig{Enabled: true},
+					BigipPoolMemberMonitorStatus:         MetricConfig{Enabled: true},
+					BigipPoolMemberPacketCount:           MetricConfig{Enabled: true},
+					BigipPoolMemberRequestCount:          MetricConfig{Enabled: true},
+					BigipPoolMemberSessionCount:          MetricConfig{Enabled: true},
+					BigipSnatpoolConnections:             MetricConfig{Enabled: true},
+					BigipSnatpoolTranslationAddressCount: MetricConfig{Enabled: true},
+					BigipSslProfileCipherCount:           MetricConfig{Enabled: true},
+					BigipSslProfileConnectionsCurrent:    MetricConfig{Enabled: true},
+					BigipSslProfileHandshakes:            MetricConfig{Enabled: true},
+					BigipSslProfileKeyExchangeCount:      MetricConfig{Enabled: true},
+					BigipSslProfileProtocolCount:         MetricConfig{Enabled: true},
+					BigipSystemMemoryTotal:               MetricConfig{Enabled: true},
+					BigipSystemMemoryUsed:                MetricConfig{Enabled: true},
+					BigipSystemTmmCPUUtilization:         MetricConfig{Enabled: true},
+					BigipVirtualServerAvailability:       MetricConfig{Enabled: true},
+					BigipVirtualServerConnectionCount:    MetricConfig{Enabled: true},
+					BigipVirtualServerDataTransmitted:    MetricConfig{Enabled: true},
+					BigipVirtualServerEnabled:            MetricConfig{Enabled: true},
+					BigipVirtualServerPacketCount:        MetricConfig{Enabled: true},
+					BigipVirtualServerRequestCount:       MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
+					BigipDeviceEndpoint:           ResourceAttributeConfig{Enabled: true},
+					BigipHTTPProfileName:          ResourceAttributeConfig{Enabled: true},
 					BigipNodeIPAddress:            ResourceAttributeConfig{Enabled: true},
 					BigipNodeName:                 ResourceAttributeConfig{Enabled: true},
 					BigipPoolName:                 ResourceAttributeConfig{Enabled: true},
 					BigipPoolMemberIPAddress:      ResourceAttributeConfig{Enabled: true},
 					BigipPoolMemberName:           ResourceAttributeConfig{Enabled: true},
+					BigipSslProfileName:           ResourceAttributeConfig{Enabled: true},
 					BigipVirtualServerDestination: ResourceAttributeConfig{Enabled: true},
 					BigipVirtualServerName:        ResourceAttributeConfig{Enabled: true},
 				},
@@ -68,40 +92,64 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					BigipNodeAvailability:             MetricConfig{Enabled: false},
-					BigipNodeConnectionCount:          MetricConfig{Enabled: false},
-					BigipNodeDataTransmitted:          MetricConfig{Enabled: false},
-					BigipNodeEnabled:                  MetricConfig{Enabled: false},
-					BigipNodePacketCount:              MetricConfig{Enabled: false},
-					BigipNodeRequestCount:             MetricConfig{Enabled: false},
-					BigipNodeSessionCount:             MetricConfig{Enabled: false},
-					BigipPoolAvailability:             MetricConfig{Enabled: false},
-					BigipPoolConnectionCount:          MetricConfig{Enabled: false},
-					BigipPoolDataTransmitted:          MetricConfig{Enabled: false},
-					BigipPoolEnabled:                  MetricConfig{Enabled: false},
-					BigipPoolMemberCount:              MetricConfig{Enabled: false},
-					BigipPoolPacketCount:              MetricConfig{Enabled: false},
-					BigipPoolRequestCount:             MetricConfig{Enabled: false},
-					BigipPoolMemberAvailability:       MetricConfig{Enabled: false},
-					BigipPoolMemberConnectionCount:    MetricConfig{Enabled: false},
-					BigipPoolMemberDataTransmitted:    MetricConfig{Enabled: false},
-					BigipPoolMemberEnabled:            MetricConfig{Enabled: false},
-					BigipPoolMemberPacketCount:        MetricConfig{Enabled: false},
-					BigipPoolMemberRequestCount:       MetricConfig{Enabled: false},
-					BigipPoolMemberSessionCount:       MetricConfig{Enabled: false},
-					BigipVirtualServerAvailability:    MetricConfig{Enabled: false},
-					BigipVirtualServerConnectionCount: MetricConfig{Enabled: false},
-					BigipVirtualServerDataTransmitted: MetricConfig{Enabled: false},
-					BigipVirtualServerEnabled:         MetricConfig{Enabled: false},
-					BigipVirtualServerPacketCount:     MetricConfig{Enabled: false},
-					BigipVirtualServerRequestCount:    MetricConfig{Enabled: false},
+					BigipCmFailoverActive:                MetricConfig{Enabled: false},
+					BigipCmSyncStatus:                    MetricConfig{Enabled: false},
+					BigipDeviceConnectionCount:           MetricConfig{Enabled: false},
+					BigipDeviceDataTransmitted:           MetricConfig{Enabled: false},
+					BigipDevicePacketCount:               MetricConfig{Enabled: false},
+					BigipHTTPResponses:                   MetricConfig{Enabled: false},
+					BigipNetInterfaceBitsReceived:        MetricConfig{Enabled: false},
+					BigipNetInterfaceBitsSent:            MetricConfig{Enabled: false},
+					BigipNetInterfaceDropsReceived:       MetricConfig{Enabled: false},
+					BigipNetInterfaceDropsSent:           MetricConfig{Enabled: false},
+					BigipNodeAvailability:                MetricConfig{Enabled: false},
+					BigipNodeConnectionCount:             MetricConfig{Enabled: false},
+					BigipNodeDataTransmitted:             MetricConfig{Enabled: false},
+					BigipNodeEnabled:                     MetricConfig{Enabled: false},
+					BigipNodePacketCount:                 MetricConfig{Enabled: false},
+					BigipNodeRequestCount:                MetricConfig{Enabled: false},
+					BigipNodeSessionCount:                MetricConfig{Enabled: false},
+					BigipPoolAvailability:                MetricConfig{Enabled: false},
+					BigipPoolConnectionCount:             MetricConfig{Enabled: false},
+					BigipPoolDataTransmitted:             MetricConfig{Enabled: false},
+					BigipPoolEnabled:                     MetricConfig{Enabled: false},
+					BigipPoolMemberCount:                 MetricConfig{Enabled: false},
+					BigipPoolPacketCount:                 MetricConfig{Enabled: false},
+					BigipPoolRequestCount:                MetricConfig{Enabled: false},
+					BigipPoolMemberAvailability:          MetricConfig{Enabled: false},
+					BigipPoolMemberConnectionCount:       MetricConfig{Enabled: false},
+					BigipPoolMemberDataTransmitted:       MetricConfig{Enabled: false},
+					BigipPoolMemberEnabled:               MetricConfig{Enabled: false},
+					BigipPoolMemberMonitorStatus:         MetricConfig{Enabled: false},
+					BigipPoolMemberPacketCount:           MetricConfig{Enabled: false},
+					BigipPoolMemberRequestCount:          MetricConfig{Enabled: false},
+					BigipPoolMemberSessionCount:          MetricConfig{Enabled: false},
+					BigipSnatpoolConnections:             MetricConfig{Enabled: false},
+					BigipSnatpoolTranslationAddressCount: MetricConfig{Enabled: false},
+					BigipSslProfileCipherCount:           MetricConfig{Enabled: false},
+					BigipSslProfileConnectionsCurrent:    MetricConfig{Enabled: false},
+					BigipSslProfileHandshakes:            MetricConfig{Enabled: false},
+					BigipSslProfileKeyExchangeCount:      MetricConfig{Enabled: false},
+					BigipSslProfileProtocolCount:         MetricConfig{Enabled: false},
+					BigipSystemMemoryTotal:               MetricConfig{Enabled: false},
+					BigipSystemMemoryUsed:                MetricConfig{Enabled: false},
+					BigipSystemTmmCPUUtilization:         MetricConfig{Enabled: false},
+					BigipVirtualServerAvailability:       MetricConfig{Enabled: false},
+					BigipVirtualServerConnectionCount:    MetricConfig{Enabled: false},
+					BigipVirtualServerDataTransmitted:    MetricConfig{Enabled: false},
+					BigipVirtualServerEnabled:            MetricConfig{Enabled: false},
+					BigipVirtualServerPacketCount:        MetricConfig{Enabled: false},
+					BigipVirtualServerRequestCount:       MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
+					BigipDeviceEndpoint:           ResourceAttributeConfig{Enabled: false},
+					BigipHTTPProfileName:          ResourceAttributeConfig{Enabled: false},
 					BigipNodeIPAddress:            ResourceAttributeConfig{Enabled: false},
 					BigipNodeName:                 ResourceAttributeConfig{Enabled: false},
 					BigipPoolName:                 ResourceAttributeConfig{Enabled: false},
 					BigipPoolMemberIPAddress:      ResourceAttributeConfig{Enabled: false},
 					BigipPoolMemberName:           ResourceAttributeConfig{Enabled: false},
+					BigipSslProfileName:           ResourceAttributeConfig{Enabled: false},
 					BigipVirtualServerDestination: ResourceAttributeConfig{Enabled: false},
 					BigipVirtualServerName:        ResourceAttributeConfig{Enabled: false},
 				},
@@ -139,11 +187,14 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "all_set",
 			want: ResourceAttributesConfig{
+				BigipDeviceEndpoint:           ResourceAttributeConfig{Enabled: true},
+				BigipHTTPProfileName:          ResourceAttributeConfig{Enabled: true},
 				BigipNodeIPAddress:            ResourceAttributeConfig{Enabled: true},
 				BigipNodeName:                 ResourceAttributeConfig{Enabled: true},
 				BigipPoolName:                 ResourceAttributeConfig{Enabled: true},
 				BigipPoolMemberIPAddress:      ResourceAttributeConfig{Enabled: true},
 				BigipPoolMemberName:           ResourceAttributeConfig{Enabled: true},
+				BigipSslProfileName:           ResourceAttributeConfig{Enabled: true},
 				BigipVirtualServerDestination: ResourceAttributeConfig{Enabled: true},
 				BigipVirtualServerName:        ResourceAttributeConfig{Enabled: true},
 			},
@@ -151,11 +202,14 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "none_set",
 			want: ResourceAttributesConfig{
+				BigipDeviceEndpoint:           ResourceAttributeConfig{Enabled: false},
+				BigipHTTPProfileName:          ResourceAttributeConfig{Enabled: false},
 				BigipNodeIPAddress:            ResourceAttributeConfig{Enabled: false},
 				BigipNodeName:                 ResourceAttributeConfig{Enabled: false},
 				BigipPoolName:                 ResourceAttributeConfig{Enabled: false},
 				BigipPoolMemberIPAddress:      ResourceAttributeConfig{Enabled: false},
 				BigipPoolMemberName:           ResourceAttributeConfig{Enabled: false},
+				BigipSslProfileName:           ResourceAttributeConfig{Enabled: false},
 				BigipVirtualServerDestination: ResourceAttributeConfig{Enabled: false},
 				BigipVirtualServerName:        ResourceAttributeConfig{Enabled: false},
 			},
@@ -68,6 +68,40 @@ var MapAttributeAvailabilityStatus = map[string]AttributeAvailabilityStatus{
 	"available": AttributeAvailabilityStatusAvailable,
 }
 
+// AttributeCodeClass specifies the value code.class attribute.
+type AttributeCodeClass int
+
+const (
+	_ AttributeCodeClass = iota
+	AttributeCodeClass2xx
+	AttributeCodeClass3xx
+	AttributeCodeClass4xx
+	AttributeCodeClass5xx
+)
+
+// String returns the string representation of the AttributeCodeClass.
+func (av AttributeCodeClass) String() string {
+	switch av {
+	case AttributeCodeClass2xx:
+		return "2xx"
+	case AttributeCodeClass3xx:
+		return "3xx"
+	case AttributeCodeClass4xx:
+		return "4xx"
+	case AttributeCodeClass5xx:
+		return "5xx"
+	}
+	return ""
+}
+
+// MapAttributeCodeClass is a helper map of string to AttributeCodeClass attribute value.
+var MapAttributeCodeClass = map[string]AttributeCodeClass{
+	"2xx": AttributeCodeClass2xx,
+	"3xx": AttributeCodeClass3xx,
+	"4xx": AttributeCodeClass4xx,
+	"5xx": AttributeCodeClass5xx,
+}
+
 // AttributeDirection specifies the value direction attribute.
 type AttributeDirection int
 
@@ -120,7 +154,143 @@ var MapAttributeEnabledStatus = map[string]AttributeEnabledStatus{
 	"enabled":  AttributeEnabledStatusEnabled,
 }
 
+// AttributeHandshakeResult specifies the value handshake.result attribute.
+type AttributeHandshakeResult int
+
+const (
+	_ AttributeHandshakeResult = iota
+	AttributeHandshakeResultSuccess
+	AttributeHandshakeResultFailure
+)
+
+// String returns the string representation of the AttributeHandshakeResult.
+func (av AttributeHandshakeResult) String() string {
+	switch av {
+	case AttributeHandshakeResultSuccess:
+		return "success"
+	case AttributeHandshakeResultFailure:
+		return "failure"
+	}
+	return ""
+}
+
+// MapAttributeHandshakeResult is a helper map of string to AttributeHandshakeResult attribute value.
+var MapAttributeHandshakeResult = map[string]AttributeHandshakeResult{
+	"success": AttributeHandshakeResultSuccess,
+	"failure": AttributeHandshakeResultFailure,
+}
+
+// AttributeMemoryType specifies the value memory.type attribute.
+type AttributeMemoryType int
+
+const (
+	_ AttributeMemoryType = iota
+	AttributeMemoryTypeTmm
+	AttributeMemoryTypeOther
+)
+
+// String returns the string representation of the AttributeMemoryType.
+func (av AttributeMemoryType) String() string {
+	switch av {
+	case AttributeMemoryTypeTmm:
+		return "tmm"
+	case AttributeMemoryTypeOther:
+		return "other"
+	}
+	return ""
+}
+
+// MapAttributeMemoryType is a helper map of string to AttributeMemoryType attribute value.
+var MapAttributeMemoryType = map[string]AttributeMemoryType{
+	"tmm":   AttributeMemoryTypeTmm,
+	"other": AttributeMemoryTypeOther,
+}
+
+// AttributeSyncStatus specifies the value sync.status attribute.
+type AttributeSyncStatus int
+
+const (
+	_ AttributeSyncStatus = iota
+	AttributeSyncStatusInSync
+	AttributeSyncStatusChangesPending
+	AttributeSyncStatusAwaitingInitialSync
+	AttributeSyncStatusNotAllDevicesSynced
+	AttributeSyncStatusSyncing
+	AttributeSyncStatusSyncFailure
+	AttributeSyncStatusDisconnected
+	AttributeSyncStatusStandalone
+	AttributeSyncStatusUnknown
+)
+
+// String returns the string representation of the AttributeSyncStatus.
+func (av AttributeSyncStatus) String() string {
+	switch av {
+	case AttributeSyncStatusInSync:
+		return "in_sync"
+	case AttributeSyncStatusChangesPending:
+		return "changes_pending"
+	case AttributeSyncStatusAwaitingInitialSync:
+		return "awaiting_initial_sync"
+	case AttributeSyncStatusNotAllDevicesSynced:
+		return "not_all_devices_synced"
+	case AttributeSyncStatusSyncing:
+		return "syncing"
+	case AttributeSyncStatusSyncFailure:
+		return "sync_failure"
+	case AttributeSyncStatusDisconnected:
+		return "disconnected"
+	case AttributeSyncStatusStandalone:
+		return "standalone"
+	case AttributeSyncStatusUnknown:
+		return "unknown"
+	}
+	return ""
+}
+
+// MapAttributeSyncStatus is a helper map of string to AttributeSyncStatus attribute value.
+var MapAttributeSyncStatus = map[string]AttributeSyncStatus{
+	"in_sync":                AttributeSyncStatusInSync,
+	"changes_pending":        AttributeSyncStatusChangesPending,
+	"awaiting_initial_sync":  AttributeSyncStatusAwaitingInitialSync,
+	"not_all_devices_synced": AttributeSyncStatusNotAllDevicesSynced,
+	"syncing":                AttributeSyncStatusSyncing,
+	"sync_failure":           AttributeSyncStatusSyncFailure,
+	"disconnected":           AttributeSyncStatusDisconnected,
+	"standalone":             AttributeSyncStatusStandalone,
+	"unknown":                AttributeSyncStatusUnknown,
+}
+
 var MetricsInfo = metricsInfo{
+	BigipCmFailoverActive: metricInfo{
+		Name: "bigip.cm.failover.active",
+	},
+	BigipCmSyncStatus: metricInfo{
+		Name: "bigip.cm.sync.status",
+	},
+	BigipDeviceConnectionCount: metricInfo{
+		Name: "bigip.device.connection.count",
+	},
+	BigipDeviceDataTransmitted: metricInfo{
+		Name: "bigip.device.data.transmitted",
+	},
+	BigipDevicePacketCount: metricInfo{
+		Name: "bigip.device.packet.count",
+	},
+	BigipHTTPResponses: metricInfo{
+		Name: "bigip.http.responses",
+	},
+	BigipNetInterfaceBitsReceived: metricInfo{
+		Name: "bigip.net.interface.bits.received",
+	},
+	BigipNetInterfaceBitsSent: metricInfo{
+		Name: "bigip.net.interface.bits.sent",
+	},
+	BigipNetInterfaceDropsReceived: metricInfo{
+		Name: "bigip.net.interface.drops.received",
+	},
+	BigipNetInterfaceDropsSent: metricInfo{
+		Name: "bigip.net.interface.drops.sent",
+	},
 	BigipNodeAvailability: metricInfo{
 		Name: "bigip.node.availability",
 	},
@@ -175,6 +345,9 @@ var MetricsInfo = metricsInfo{
 	BigipPoolMemberEnabled: metricInfo{
 		Name: "bigip.pool_member.enabled",
 	},
+	BigipPoolMemberMonitorStatus: metricInfo{
+		Name: "bigip.pool_member.monitor.status",
+	},
 	BigipPoolMemberPacketCount: metricInfo{
 		Name: "bigip.pool_member.packet.count",
 	},
@@ -184,6 +357,36 @@ var MetricsInfo = metricsInfo{
 	BigipPoolMemberSessionCount: metricInfo{
 		Name: "bigip.pool_member.session.count",
 	},
+	BigipSnatpoolConnections: metricInfo{
+		Name: "bigip.snatpool.connections",
+	},
+	BigipSnatpoolTranslationAddressCount: metricInfo{
+		Name: "bigip.snatpool.translation_address.count",
+	},
+	BigipSslProfileCipherCount: metricInfo{
+		Name: "bigip.ssl.profile.cipher.count",
+	},
+	BigipSslProfileConnectionsCurrent: metricInfo{
+		Name: "bigip.ssl.profile.connections.current",
+	},
+	BigipSslProfileHandshakes: metricInfo{
+		Name: "bigip.ssl.profile.handshakes",
+	},
+	BigipSslProfileKeyExchangeCount: metricInfo{
+		Name: "bigip.ssl.profile.key_exchange.count",
+	},
+	BigipSslProfileProtocolCount: metricInfo{
+		Name: "bigip.ssl.profile.protocol.count",
+	},
+	BigipSystemMemoryTotal: metricInfo{
+		Name: "bigip.system.memory.total",
+	},
+	BigipSystemMemoryUsed: metricInfo{
+		Name: "bigip.system.memory.used",
+	},
+	BigipSystemTmmCPUUtilization: metricInfo{
+		Name: "bigip.system.tmm.cpu.utilization",
+	},
 	BigipVirtualServerAvailability: metricInfo{
 		Name: "bigip.virtual_server.availability",
 	},
@@ -205,55 +408,76 @@ var MetricsInfo = metricsInfo{
 }
 
 type metricsInfo struct {
-	BigipNodeAvailability             metricInfo
-	BigipNodeConnectionCount          metricInfo
-	BigipNodeDataTransmitted          metricInfo
-	BigipNodeEnabled                  metricInfo
-	BigipNodePacketCount              metricInfo
-	BigipNodeRequestCount             metricInfo
-	BigipNodeSessionCount             metricInfo
-	BigipPoolAvailability             metricInfo
-	BigipPoolConnectionCount          metricInfo
-	BigipPoolDataTransmitted          metricInfo
-	BigipPoolEnabled                  metricInfo
-	BigipPoolMemberCount              metricInfo
-	BigipPoolPacketCount              metricInfo
-	BigipPoolRequestCount             metricInfo
-	BigipPoolMemberAvailability       metricInfo
-	BigipPoolMemberConnectionCount    metricInfo
-	BigipPoolMemberDataTransmitted    metricInfo
-	BigipPoolMemberEnabled            metricInfo
-	BigipPoolMemberPacketCount        metricInfo
-	BigipPoolMemberRequestCount       metricInfo
-	BigipPoolMemberSessionCount       metricInfo
-	BigipVirtualServerAvailability    metricInfo
-	BigipVirtualServerConnectionCount metricInfo
-	BigipVirtualServerDataTransmitted metricInfo
-	BigipVirtualServerEnabled         metricInfo
-	BigipVirtualServerPacketCount     metricInfo
-	BigipVirtualServerRequestCount    metricInfo
+	BigipCmFailoverActive                metricInfo
+	BigipCmSyncStatus                    metricInfo
+	BigipDeviceConnectionCount           metricInfo
+	BigipDeviceDataTransmitted           metricInfo
+	BigipDevicePacketCount               metricInfo
+	BigipHTTPResponses                   metricInfo
+	BigipNetInterfaceBitsReceived        metricInfo
+	BigipNetInterfaceBitsSent            metricInfo
+	BigipNetInterfaceDropsReceived       metricInfo
+	BigipNetInterfaceDropsSent           metricInfo
+	BigipNodeAvailability                metricInfo
+	BigipNodeConnectionCount             metricInfo
+	BigipNodeDataTransmitted             metricInfo
+	BigipNodeEnabled                     metricInfo
+	BigipNodePacketCount                 metricInfo
+	BigipNodeRequestCount                metricInfo
+	BigipNodeSessionCount                metricInfo
+	BigipPoolAvailability                metricInfo
+	BigipPoolConnectionCount             metricInfo
+	BigipPoolDataTransmitted             metricInfo
+	BigipPoolEnabled                     metricInfo
+	BigipPoolMemberCount                 metricInfo
+	BigipPoolPacketCount                 metricInfo
+	BigipPoolRequestCount                metricInfo
+	BigipPoolMemberAvailability          metricInfo
+	BigipPoolMemberConnectionCount       metricInfo
+	BigipPoolMemberDataTransmitted       metricInfo
+	BigipPoolMemberEnabled               metricInfo
+	BigipPoolMemberMonitorStatus         metricInfo
+	BigipPoolMemberPacketCount           metricInfo
+	BigipPoolMemberRequestCount          metricInfo
+	BigipPoolMemberSessionCount          metricInfo
+	BigipSnatpoolConnections             metricInfo
+	BigipSnatpoolTranslationAddressCount metricInfo
+	BigipSslProfileCipherCount           metricInfo
+	BigipSslProfileConnectionsCurrent    metricInfo
+	BigipSslProfileHandshakes            metricInfo
+	BigipSslProfileKeyExchangeCount      metricInfo
+	BigipSslProfileProtocolCount         metricInfo
+	BigipSystemMemoryTotal               metricInfo
+	BigipSystemMemoryUsed                metricInfo
+	BigipSystemTmmCPUUtilization         metricInfo
+	BigipVirtualServerAvailability       metricInfo
+	BigipVirtualServerConnectionCount    metricInfo
+	BigipVirtualServerDataTransmitted    metricInfo
+	BigipVirtualServerEnabled            metricInfo
+	BigipVirtualServerPacketCount        metricInfo
+	BigipVirtualServerRequestCount       metricInfo
 }
 
 type metricInfo struct {
 	Name string
 }
 
-type metricBigipNodeAvailability struct {
+type metricBigipCmFailoverActive struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.node.availability metric with initial data.
-func (m *metricBigipNodeAvailability) init() {
-	m.data.SetName("bigip.node.availability")
-	m.data.SetDescription("Availability of the node.")
+// init fills bigip.cm.failover.active metric with initial data.
+func (m *metricBigipCmFailoverActive) init() {
+	m.data.SetName("bigip.cm.failover.active")
+	m.data.SetDescription("Whether the device is the active unit of its failover group, 1 when active and 0 otherwise.")
 	m.data.SetUnit("1")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipNodeAvailability) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityStatusAttributeValue string) {
+func (m *metricBigipCmFailoverActive) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -261,18 +485,18 @@ func (m *metricBigipNodeAvailability) recordDataPoint(start pcommon.Timestamp, t
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("status", availabilityStatusAttributeValue)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipNodeAvailability) updateCapacity() {
+func (m *metricBigipCmFailoverActive) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipNodeAvailability) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipCmFailoverActive) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -280,8 +504,8 @@ func (m *metricBigipNodeAvailability) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricBigipNodeAvailability(cfg MetricConfig) metricBigipNodeAvailability {
-	m := metricBigipNodeAvailability{config: cfg}
+func newMetricBigipCmFailoverActive(cfg MetricConfig) metricBigipCmFailoverActive {
+	m := metricBigipCmFailoverActive{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -289,50 +513,51 @@ func newMetricBigipNodeAvailability(cfg MetricConfig) metricBigipNodeAvailabilit
 	return m
 }
 
-type metricBigipNodeConnectionCount struct {
+type metricBigipCmSyncStatus struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.node.connection.count metric with initial data.
-func (m *metricBigipNodeConnectionCount) init() {
-	m.data.SetName("bigip.node.connection.count")
-	m.data.SetDescription("Current number of connections to the node.")
-	m.data.SetUnit("{connections}")
-	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(false)
-	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+// init fills bigip.cm.sync.status metric with initial data.
+func (m *metricBigipCmSyncStatus) init() {
+	m.data.SetName("bigip.cm.sync.status")
+	m.data.SetDescription("Config sync status of the device.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipNodeConnectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricBigipCmSyncStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, syncStatusAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("status", syncStatusAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipNodeConnectionCount) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricBigipCmSyncStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipNodeConnectionCount) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricBigipCmSyncStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricBigipNodeConnectionCount(cfg MetricConfig) metricBigipNodeConnectionCount {
-	m := metricBigipNodeConnectionCount{config: cfg}
+func newMetricBigipCmSyncStatus(cfg MetricConfig) metricBigipCmSyncStatus {
+	m := metricBigipCmSyncStatus{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -340,24 +565,23 @@ func newMetricBigipNodeConnectionCount(cfg MetricConfig) metricBigipNodeConnecti
 	return m
 }
 
-type metricBigipNodeDataTransmitted struct {
+type metricBigipDeviceConnectionCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.node.data.transmitted metric with initial data.
-func (m *metricBigipNodeDataTransmitted) init() {
-	m.data.SetName("bigip.node.data.transmitted")
-	m.data.SetDescription("Amount of data transmitted to and from the node.")
-	m.data.SetUnit("By")
+// init fills bigip.device.connection.count metric with initial data.
+func (m *metricBigipDeviceConnectionCount) init() {
+	m.data.SetName("bigip.device.connection.count")
+	m.data.SetDescription("Current number of client side connections to the device.")
+	m.data.SetUnit("{connections}")
 	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetIsMonotonic(false)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
-	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipNodeDataTransmitted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+func (m *metricBigipDeviceConnectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -365,18 +589,17 @@ func (m *metricBigipNodeDataTransmitted) recordDataPoint(start pcommon.Timestamp
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("direction", directionAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipNodeDataTransmitted) updateCapacity() {
+func (m *metricBigipDeviceConnectionCount) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipNodeDataTransmitted) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipDeviceConnectionCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -384,8 +607,8 @@ func (m *metricBigipNodeDataTransmitted) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricBigipNodeDataTransmitted(cfg MetricConfig) metricBigipNodeDataTransmitted {
-	m := metricBigipNodeDataTransmitted{config: cfg}
+func newMetricBigipDeviceConnectionCount(cfg MetricConfig) metricBigipDeviceConnectionCount {
+	m := metricBigipDeviceConnectionCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -393,50 +616,52 @@ func newMetricBigipNodeDataTransmitted(cfg MetricConfig) metricBigipNodeDataTran
 	return m
 }
 
-type metricBigipNodeEnabled struct {
+type metricBigipDeviceDataTransmitted struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.node.enabled metric with initial data.
-func (m *metricBigipNodeEnabled) init() {
-	m.data.SetName("bigip.node.enabled")
-	m.data.SetDescription("Enabled state of of the node.")
-	m.data.SetUnit("1")
-	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+// init fills bigip.device.data.transmitted metric with initial data.
+func (m *metricBigipDeviceDataTransmitted) init() {
+	m.data.SetName("bigip.device.data.transmitted")
+	m.data.SetDescription("Amount of client side data transmitted to and from the device.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipNodeEnabled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, enabledStatusAttributeValue string) {
+func (m *metricBigipDeviceDataTransmitted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
-	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp := m.data.Sum().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("status", enabledStatusAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipNodeEnabled) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricBigipDeviceDataTransmitted) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipNodeEnabled) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricBigipDeviceDataTransmitted) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricBigipNodeEnabled(cfg MetricConfig) metricBigipNodeEnabled {
-	m := metricBigipNodeEnabled{config: cfg}
+func newMetricBigipDeviceDataTransmitted(cfg MetricConfig) metricBigipDeviceDataTransmitted {
+	m := metricBigipDeviceDataTransmitted{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -444,16 +669,16 @@ func newMetricBigipNodeEnabled(cfg MetricConfig) metricBigipNodeEnabled {
 	return m
 }
 
-type metricBigipNodePacketCount struct {
+type metricBigipDevicePacketCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.node.packet.count metric with initial data.
-func (m *metricBigipNodePacketCount) init() {
-	m.data.SetName("bigip.node.packet.count")
-	m.data.SetDescription("Number of packets transmitted to and from the node.")
+// init fills bigip.device.packet.count metric with initial data.
+func (m *metricBigipDevicePacketCount) init() {
+	m.data.SetName("bigip.device.packet.count")
+	m.data.SetDescription("Number of client side packets transmitted to and from the device.")
 	m.data.SetUnit("{packets}")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
@@ -461,7 +686,7 @@ func (m *metricBigipNodePacketCount) init() {
 	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipNodePacketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+func (m *metricBigipDevicePacketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -473,14 +698,14 @@ func (m *metricBigipNodePacketCount) recordDataPoint(start pcommon.Timestamp, ts
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipNodePacketCount) updateCapacity() {
+func (m *metricBigipDevicePacketCount) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipNodePacketCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipDevicePacketCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -488,8 +713,8 @@ func (m *metricBigipNodePacketCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricBigipNodePacketCount(cfg MetricConfig) metricBigipNodePacketCount {
-	m := metricBigipNodePacketCount{config: cfg}
+func newMetricBigipDevicePacketCount(cfg MetricConfig) metricBigipDevicePacketCount {
+	m := metricBigipDevicePacketCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -497,23 +722,24 @@ func newMetricBigipNodePacketCount(cfg MetricConfig) metricBigipNodePacketCount
 	return m
 }
 
-type metricBigipNodeRequestCount struct {
+type metricBigipHTTPResponses struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.node.request.count metric with initial data.
-func (m *metricBigipNodeRequestCount) init() {
-	m.data.SetName("bigip.node.request.count")
-	m.data.SetDescription("Number of requests to the node.")
-	m.data.SetUnit("{requests}")
+// init fills bigip.http.responses metric with initial data.
+func (m *metricBigipHTTPResponses) init() {
+	m.data.SetName("bigip.http.responses")
+	m.data.SetDescription("Number of HTTP responses sent by the virtual servers using the HTTP profile.")
+	m.data.SetUnit("{responses}")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipNodeRequestCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricBigipHTTPResponses) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, codeClassAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -521,17 +747,18 @@ func (m *metricBigipNodeRequestCount) recordDataPoint(start pcommon.Timestamp, t
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("code.class", codeClassAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipNodeRequestCount) updateCapacity() {
+func (m *metricBigipHTTPResponses) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipNodeRequestCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipHTTPResponses) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -539,8 +766,8 @@ func (m *metricBigipNodeRequestCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricBigipNodeRequestCount(cfg MetricConfig) metricBigipNodeRequestCount {
-	m := metricBigipNodeRequestCount{config: cfg}
+func newMetricBigipHTTPResponses(cfg MetricConfig) metricBigipHTTPResponses {
+	m := metricBigipHTTPResponses{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -548,23 +775,24 @@ func newMetricBigipNodeRequestCount(cfg MetricConfig) metricBigipNodeRequestCoun
 	return m
 }
 
-type metricBigipNodeSessionCount struct {
+type metricBigipNetInterfaceBitsReceived struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.node.session.count metric with initial data.
-func (m *metricBigipNodeSessionCount) init() {
-	m.data.SetName("bigip.node.session.count")
-	m.data.SetDescription("Current number of sessions for the node.")
-	m.data.SetUnit("{sessions}")
+// init fills bigip.net.interface.bits.received metric with initial data.
+func (m *metricBigipNetInterfaceBitsReceived) init() {
+	m.data.SetName("bigip.net.interface.bits.received")
+	m.data.SetDescription("Number of bits received by the network interface.")
+	m.data.SetUnit("bit")
 	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipNodeSessionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricBigipNetInterfaceBitsReceived) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceInterfaceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -572,17 +800,18 @@ func (m *metricBigipNodeSessionCount) recordDataPoint(start pcommon.Timestamp, t
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device.interface", deviceInterfaceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipNodeSessionCount) updateCapacity() {
+func (m *metricBigipNetInterfaceBitsReceived) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipNodeSessionCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipNetInterfaceBitsReceived) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -590,8 +819,8 @@ func (m *metricBigipNodeSessionCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricBigipNodeSessionCount(cfg MetricConfig) metricBigipNodeSessionCount {
-	m := metricBigipNodeSessionCount{config: cfg}
+func newMetricBigipNetInterfaceBitsReceived(cfg MetricConfig) metricBigipNetInterfaceBitsReceived {
+	m := metricBigipNetInterfaceBitsReceived{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -599,50 +828,52 @@ func newMetricBigipNodeSessionCount(cfg MetricConfig) metricBigipNodeSessionCoun
 	return m
 }
 
-type metricBigipPoolAvailability struct {
+type metricBigipNetInterfaceBitsSent struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool.availability metric with initial data.
-func (m *metricBigipPoolAvailability) init() {
-	m.data.SetName("bigip.pool.availability")
-	m.data.SetDescription("Availability of the pool.")
-	m.data.SetUnit("1")
-	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+// init fills bigip.net.interface.bits.sent metric with initial data.
+func (m *metricBigipNetInterfaceBitsSent) init() {
+	m.data.SetName("bigip.net.interface.bits.sent")
+	m.data.SetDescription("Number of bits sent by the network interface.")
+	m.data.SetUnit("bit")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolAvailability) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityStatusAttributeValue string) {
+func (m *metricBigipNetInterfaceBitsSent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceInterfaceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
-	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp := m.data.Sum().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("status", availabilityStatusAttributeValue)
+	dp.Attributes().PutStr("device.interface", deviceInterfaceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolAvailability) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricBigipNetInterfaceBitsSent) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolAvailability) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricBigipNetInterfaceBitsSent) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricBigipPoolAvailability(cfg MetricConfig) metricBigipPoolAvailability {
-	m := metricBigipPoolAvailability{config: cfg}
+func newMetricBigipNetInterfaceBitsSent(cfg MetricConfig) metricBigipNetInterfaceBitsSent {
+	m := metricBigipNetInterfaceBitsSent{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -650,23 +881,24 @@ func newMetricBigipPoolAvailability(cfg MetricConfig) metricBigipPoolAvailabilit
 	return m
 }
 
-type metricBigipPoolConnectionCount struct {
+type metricBigipNetInterfaceDropsReceived struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool.connection.count metric with initial data.
-func (m *metricBigipPoolConnectionCount) init() {
-	m.data.SetName("bigip.pool.connection.count")
-	m.data.SetDescription("Current number of connections to the pool.")
-	m.data.SetUnit("{connections}")
+// init fills bigip.net.interface.drops.received metric with initial data.
+func (m *metricBigipNetInterfaceDropsReceived) init() {
+	m.data.SetName("bigip.net.interface.drops.received")
+	m.data.SetDescription("Number of received packets dropped by the network interface.")
+	m.data.SetUnit("{packets}")
 	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolConnectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricBigipNetInterfaceDropsReceived) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceInterfaceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -674,17 +906,18 @@ func (m *metricBigipPoolConnectionCount) recordDataPoint(start pcommon.Timestamp
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device.interface", deviceInterfaceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolConnectionCount) updateCapacity() {
+func (m *metricBigipNetInterfaceDropsReceived) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolConnectionCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipNetInterfaceDropsReceived) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -692,8 +925,8 @@ func (m *metricBigipPoolConnectionCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricBigipPoolConnectionCount(cfg MetricConfig) metricBigipPoolConnectionCount {
-	m := metricBigipPoolConnectionCount{config: cfg}
+func newMetricBigipNetInterfaceDropsReceived(cfg MetricConfig) metricBigipNetInterfaceDropsReceived {
+	m := metricBigipNetInterfaceDropsReceived{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -701,24 +934,24 @@ func newMetricBigipPoolConnectionCount(cfg MetricConfig) metricBigipPoolConnecti
 	return m
 }
 
-type metricBigipPoolDataTransmitted struct {
+type metricBigipNetInterfaceDropsSent struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool.data.transmitted metric with initial data.
-func (m *metricBigipPoolDataTransmitted) init() {
-	m.data.SetName("bigip.pool.data.transmitted")
-	m.data.SetDescription("Amount of data transmitted to and from the pool.")
-	m.data.SetUnit("By")
+// init fills bigip.net.interface.drops.sent metric with initial data.
+func (m *metricBigipNetInterfaceDropsSent) init() {
+	m.data.SetName("bigip.net.interface.drops.sent")
+	m.data.SetDescription("Number of outgoing packets dropped by the network interface.")
+	m.data.SetUnit("{packets}")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
 	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolDataTransmitted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+func (m *metricBigipNetInterfaceDropsSent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceInterfaceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -726,18 +959,18 @@ func (m *metricBigipPoolDataTransmitted) recordDataPoint(start pcommon.Timestamp
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("direction", directionAttributeValue)
+	dp.Attributes().PutStr("device.interface", deviceInterfaceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolDataTransmitted) updateCapacity() {
+func (m *metricBigipNetInterfaceDropsSent) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolDataTransmitted) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipNetInterfaceDropsSent) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -745,8 +978,8 @@ func (m *metricBigipPoolDataTransmitted) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricBigipPoolDataTransmitted(cfg MetricConfig) metricBigipPoolDataTransmitted {
-	m := metricBigipPoolDataTransmitted{config: cfg}
+func newMetricBigipNetInterfaceDropsSent(cfg MetricConfig) metricBigipNetInterfaceDropsSent {
+	m := metricBigipNetInterfaceDropsSent{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -754,22 +987,22 @@ func newMetricBigipPoolDataTransmitted(cfg MetricConfig) metricBigipPoolDataTran
 	return m
 }
 
-type metricBigipPoolEnabled struct {
+type metricBigipNodeAvailability struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool.enabled metric with initial data.
-func (m *metricBigipPoolEnabled) init() {
-	m.data.SetName("bigip.pool.enabled")
-	m.data.SetDescription("Enabled state of of the pool.")
+// init fills bigip.node.availability metric with initial data.
+func (m *metricBigipNodeAvailability) init() {
+	m.data.SetName("bigip.node.availability")
+	m.data.SetDescription("Availability of the node.")
 	m.data.SetUnit("1")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolEnabled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, enabledStatusAttributeValue string) {
+func (m *metricBigipNodeAvailability) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityStatusAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -777,27 +1010,1113 @@ func (m *metricBigipPoolEnabled) recordDataPoint(start pcommon.Timestamp, ts pco
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("status", enabledStatusAttributeValue)
+	dp.Attributes().PutStr("status", availabilityStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipNodeAvailability) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipNodeAvailability) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipNodeAvailability(cfg MetricConfig) metricBigipNodeAvailability {
+	m := metricBigipNodeAvailability{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipNodeConnectionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.node.connection.count metric with initial data.
+func (m *metricBigipNodeConnectionCount) init() {
+	m.data.SetName("bigip.node.connection.count")
+	m.data.SetDescription("Current number of connections to the node.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricBigipNodeConnectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipNodeConnectionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipNodeConnectionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipNodeConnectionCount(cfg MetricConfig) metricBigipNodeConnectionCount {
+	m := metricBigipNodeConnectionCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipNodeDataTransmitted struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.node.data.transmitted metric with initial data.
+func (m *metricBigipNodeDataTransmitted) init() {
+	m.data.SetName("bigip.node.data.transmitted")
+	m.data.SetDescription("Amount of data transmitted to and from the node.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipNodeDataTransmitted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipNodeDataTransmitted) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipNodeDataTransmitted) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipNodeDataTransmitted(cfg MetricConfig) metricBigipNodeDataTransmitted {
+	m := metricBigipNodeDataTransmitted{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipNodeEnabled struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.node.enabled metric with initial data.
+func (m *metricBigipNodeEnabled) init() {
+	m.data.SetName("bigip.node.enabled")
+	m.data.SetDescription("Enabled state of of the node.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipNodeEnabled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, enabledStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("status", enabledStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipNodeEnabled) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipNodeEnabled) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipNodeEnabled(cfg MetricConfig) metricBigipNodeEnabled {
+	m := metricBigipNodeEnabled{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipNodePacketCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.node.packet.count metric with initial data.
+func (m *metricBigipNodePacketCount) init() {
+	m.data.SetName("bigip.node.packet.count")
+	m.data.SetDescription("Number of packets transmitted to and from the node.")
+	m.data.SetUnit("{packets}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipNodePacketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipNodePacketCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipNodePacketCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipNodePacketCount(cfg MetricConfig) metricBigipNodePacketCount {
+	m := metricBigipNodePacketCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipNodeRequestCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.node.request.count metric with initial data.
+func (m *metricBigipNodeRequestCount) init() {
+	m.data.SetName("bigip.node.request.count")
+	m.data.SetDescription("Number of requests to the node.")
+	m.data.SetUnit("{requests}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricBigipNodeRequestCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipNodeRequestCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipNodeRequestCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipNodeRequestCount(cfg MetricConfig) metricBigipNodeRequestCount {
+	m := metricBigipNodeRequestCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipNodeSessionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.node.session.count metric with initial data.
+func (m *metricBigipNodeSessionCount) init() {
+	m.data.SetName("bigip.node.session.count")
+	m.data.SetDescription("Current number of sessions for the node.")
+	m.data.SetUnit("{sessions}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricBigipNodeSessionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipNodeSessionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipNodeSessionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipNodeSessionCount(cfg MetricConfig) metricBigipNodeSessionCount {
+	m := metricBigipNodeSessionCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolAvailability struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool.availability metric with initial data.
+func (m *metricBigipPoolAvailability) init() {
+	m.data.SetName("bigip.pool.availability")
+	m.data.SetDescription("Availability of the pool.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipPoolAvailability) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("status", availabilityStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolAvailability) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolAvailability) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolAvailability(cfg MetricConfig) metricBigipPoolAvailability {
+	m := metricBigipPoolAvailability{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolConnectionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool.connection.count metric with initial data.
+func (m *metricBigipPoolConnectionCount) init() {
+	m.data.SetName("bigip.pool.connection.count")
+	m.data.SetDescription("Current number of connections to the pool.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricBigipPoolConnectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolConnectionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolConnectionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolConnectionCount(cfg MetricConfig) metricBigipPoolConnectionCount {
+	m := metricBigipPoolConnectionCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolDataTransmitted struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool.data.transmitted metric with initial data.
+func (m *metricBigipPoolDataTransmitted) init() {
+	m.data.SetName("bigip.pool.data.transmitted")
+	m.data.SetDescription("Amount of data transmitted to and from the pool.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipPoolDataTransmitted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolDataTransmitted) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolDataTransmitted) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolDataTransmitted(cfg MetricConfig) metricBigipPoolDataTransmitted {
+	m := metricBigipPoolDataTransmitted{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolEnabled struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool.enabled metric with initial data.
+func (m *metricBigipPoolEnabled) init() {
+	m.data.SetName("bigip.pool.enabled")
+	m.data.SetDescription("Enabled state of of the pool.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipPoolEnabled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, enabledStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("status", enabledStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolEnabled) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolEnabled) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolEnabled(cfg MetricConfig) metricBigipPoolEnabled {
+	m := metricBigipPoolEnabled{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolMemberCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool.member.count metric with initial data.
+func (m *metricBigipPoolMemberCount) init() {
+	m.data.SetName("bigip.pool.member.count")
+	m.data.SetDescription("Total number of pool members.")
+	m.data.SetUnit("{members}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipPoolMemberCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, activeStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("status", activeStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolMemberCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolMemberCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolMemberCount(cfg MetricConfig) metricBigipPoolMemberCount {
+	m := metricBigipPoolMemberCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolPacketCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool.packet.count metric with initial data.
+func (m *metricBigipPoolPacketCount) init() {
+	m.data.SetName("bigip.pool.packet.count")
+	m.data.SetDescription("Number of packets transmitted to and from the pool.")
+	m.data.SetUnit("{packets}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipPoolPacketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolPacketCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolPacketCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolPacketCount(cfg MetricConfig) metricBigipPoolPacketCount {
+	m := metricBigipPoolPacketCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolRequestCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool.request.count metric with initial data.
+func (m *metricBigipPoolRequestCount) init() {
+	m.data.SetName("bigip.pool.request.count")
+	m.data.SetDescription("Number of requests to the pool.")
+	m.data.SetUnit("{requests}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricBigipPoolRequestCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolRequestCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolRequestCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolRequestCount(cfg MetricConfig) metricBigipPoolRequestCount {
+	m := metricBigipPoolRequestCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolMemberAvailability struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool_member.availability metric with initial data.
+func (m *metricBigipPoolMemberAvailability) init() {
+	m.data.SetName("bigip.pool_member.availability")
+	m.data.SetDescription("Availability of the pool member.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipPoolMemberAvailability) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("status", availabilityStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolMemberAvailability) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolMemberAvailability) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolMemberAvailability(cfg MetricConfig) metricBigipPoolMemberAvailability {
+	m := metricBigipPoolMemberAvailability{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolMemberConnectionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool_member.connection.count metric with initial data.
+func (m *metricBigipPoolMemberConnectionCount) init() {
+	m.data.SetName("bigip.pool_member.connection.count")
+	m.data.SetDescription("Current number of connections to the pool member.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricBigipPoolMemberConnectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolMemberConnectionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolMemberConnectionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolMemberConnectionCount(cfg MetricConfig) metricBigipPoolMemberConnectionCount {
+	m := metricBigipPoolMemberConnectionCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolMemberDataTransmitted struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool_member.data.transmitted metric with initial data.
+func (m *metricBigipPoolMemberDataTransmitted) init() {
+	m.data.SetName("bigip.pool_member.data.transmitted")
+	m.data.SetDescription("Amount of data transmitted to and from the pool member.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipPoolMemberDataTransmitted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolMemberDataTransmitted) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolMemberDataTransmitted) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolMemberDataTransmitted(cfg MetricConfig) metricBigipPoolMemberDataTransmitted {
+	m := metricBigipPoolMemberDataTransmitted{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolMemberEnabled struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool_member.enabled metric with initial data.
+func (m *metricBigipPoolMemberEnabled) init() {
+	m.data.SetName("bigip.pool_member.enabled")
+	m.data.SetDescription("Enabled state of of the pool member.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipPoolMemberEnabled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, enabledStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("status", enabledStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolMemberEnabled) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolMemberEnabled) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolMemberEnabled(cfg MetricConfig) metricBigipPoolMemberEnabled {
+	m := metricBigipPoolMemberEnabled{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolMemberMonitorStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool_member.monitor.status metric with initial data.
+func (m *metricBigipPoolMemberMonitorStatus) init() {
+	m.data.SetName("bigip.pool_member.monitor.status")
+	m.data.SetDescription("Monitor status of the pool member. A single data point is reported with the current availability status and the reason given by the health monitor. The reason is free-form text, every distinct reason creates a new time series.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipPoolMemberMonitorStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityStatusAttributeValue string, statusReasonAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("status", availabilityStatusAttributeValue)
+	dp.Attributes().PutStr("reason", statusReasonAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolMemberMonitorStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolMemberMonitorStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolMemberMonitorStatus(cfg MetricConfig) metricBigipPoolMemberMonitorStatus {
+	m := metricBigipPoolMemberMonitorStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolMemberPacketCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool_member.packet.count metric with initial data.
+func (m *metricBigipPoolMemberPacketCount) init() {
+	m.data.SetName("bigip.pool_member.packet.count")
+	m.data.SetDescription("Number of packets transmitted to and from the pool member.")
+	m.data.SetUnit("{packets}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricBigipPoolMemberPacketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolMemberPacketCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolMemberPacketCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolMemberPacketCount(cfg MetricConfig) metricBigipPoolMemberPacketCount {
+	m := metricBigipPoolMemberPacketCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolMemberRequestCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool_member.request.count metric with initial data.
+func (m *metricBigipPoolMemberRequestCount) init() {
+	m.data.SetName("bigip.pool_member.request.count")
+	m.data.SetDescription("Number of requests to the pool member.")
+	m.data.SetUnit("{requests}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricBigipPoolMemberRequestCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricBigipPoolMemberRequestCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricBigipPoolMemberRequestCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricBigipPoolMemberRequestCount(cfg MetricConfig) metricBigipPoolMemberRequestCount {
+	m := metricBigipPoolMemberRequestCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricBigipPoolMemberSessionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills bigip.pool_member.session.count metric with initial data.
+func (m *metricBigipPoolMemberSessionCount) init() {
+	m.data.SetName("bigip.pool_member.session.count")
+	m.data.SetDescription("Current number of sessions for the pool member.")
+	m.data.SetUnit("{sessions}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricBigipPoolMemberSessionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolEnabled) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricBigipPoolMemberSessionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolEnabled) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricBigipPoolMemberSessionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricBigipPoolEnabled(cfg MetricConfig) metricBigipPoolEnabled {
-	m := metricBigipPoolEnabled{config: cfg}
+func newMetricBigipPoolMemberSessionCount(cfg MetricConfig) metricBigipPoolMemberSessionCount {
+	m := metricBigipPoolMemberSessionCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -805,24 +2124,24 @@ func newMetricBigipPoolEnabled(cfg MetricConfig) metricBigipPoolEnabled {
 	return m
 }
 
-type metricBigipPoolMemberCount struct {
+type metricBigipSnatpoolConnections struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool.member.count metric with initial data.
-func (m *metricBigipPoolMemberCount) init() {
-	m.data.SetName("bigip.pool.member.count")
-	m.data.SetDescription("Total number of pool members.")
-	m.data.SetUnit("{members}")
+// init fills bigip.snatpool.connections metric with initial data.
+func (m *metricBigipSnatpoolConnections) init() {
+	m.data.SetName("bigip.snatpool.connections")
+	m.data.SetDescription("Current number of server side connections using the translation addresses of the SNAT pool.")
+	m.data.SetUnit("{connections}")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(false)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
 	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolMemberCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, activeStatusAttributeValue string) {
+func (m *metricBigipSnatpoolConnections) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, snatpoolNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -830,18 +2149,18 @@ func (m *metricBigipPoolMemberCount) recordDataPoint(start pcommon.Timestamp, ts
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("status", activeStatusAttributeValue)
+	dp.Attributes().PutStr("snatpool.name", snatpoolNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolMemberCount) updateCapacity() {
+func (m *metricBigipSnatpoolConnections) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolMemberCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipSnatpoolConnections) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -849,8 +2168,8 @@ func (m *metricBigipPoolMemberCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricBigipPoolMemberCount(cfg MetricConfig) metricBigipPoolMemberCount {
-	m := metricBigipPoolMemberCount{config: cfg}
+func newMetricBigipSnatpoolConnections(cfg MetricConfig) metricBigipSnatpoolConnections {
+	m := metricBigipSnatpoolConnections{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -858,24 +2177,24 @@ func newMetricBigipPoolMemberCount(cfg MetricConfig) metricBigipPoolMemberCount
 	return m
 }
 
-type metricBigipPoolPacketCount struct {
+type metricBigipSnatpoolTranslationAddressCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool.packet.count metric with initial data.
-func (m *metricBigipPoolPacketCount) init() {
-	m.data.SetName("bigip.pool.packet.count")
-	m.data.SetDescription("Number of packets transmitted to and from the pool.")
-	m.data.SetUnit("{packets}")
+// init fills bigip.snatpool.translation_address.count metric with initial data.
+func (m *metricBigipSnatpoolTranslationAddressCount) init() {
+	m.data.SetName("bigip.snatpool.translation_address.count")
+	m.data.SetDescription("Number of translation addresses of the SNAT pool.")
+	m.data.SetUnit("{addresses}")
 	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetIsMonotonic(false)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
 	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolPacketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+func (m *metricBigipSnatpoolTranslationAddressCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, snatpoolNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -883,18 +2202,18 @@ func (m *metricBigipPoolPacketCount) recordDataPoint(start pcommon.Timestamp, ts
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("direction", directionAttributeValue)
+	dp.Attributes().PutStr("snatpool.name", snatpoolNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolPacketCount) updateCapacity() {
+func (m *metricBigipSnatpoolTranslationAddressCount) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolPacketCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipSnatpoolTranslationAddressCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -902,8 +2221,8 @@ func (m *metricBigipPoolPacketCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricBigipPoolPacketCount(cfg MetricConfig) metricBigipPoolPacketCount {
-	m := metricBigipPoolPacketCount{config: cfg}
+func newMetricBigipSnatpoolTranslationAddressCount(cfg MetricConfig) metricBigipSnatpoolTranslationAddressCount {
+	m := metricBigipSnatpoolTranslationAddressCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -911,23 +2230,24 @@ func newMetricBigipPoolPacketCount(cfg MetricConfig) metricBigipPoolPacketCount
 	return m
 }
 
-type metricBigipPoolRequestCount struct {
+type metricBigipSslProfileCipherCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool.request.count metric with initial data.
-func (m *metricBigipPoolRequestCount) init() {
-	m.data.SetName("bigip.pool.request.count")
-	m.data.SetDescription("Number of requests to the pool.")
-	m.data.SetUnit("{requests}")
+// init fills bigip.ssl.profile.cipher.count metric with initial data.
+func (m *metricBigipSslProfileCipherCount) init() {
+	m.data.SetName("bigip.ssl.profile.cipher.count")
+	m.data.SetDescription("Number of connections negotiated with each bulk cipher family by the client SSL profile.")
+	m.data.SetUnit("{connections}")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolRequestCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricBigipSslProfileCipherCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, sslCipherAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -935,17 +2255,18 @@ func (m *metricBigipPoolRequestCount) recordDataPoint(start pcommon.Timestamp, t
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cipher", sslCipherAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolRequestCount) updateCapacity() {
+func (m *metricBigipSslProfileCipherCount) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolRequestCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipSslProfileCipherCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -953,8 +2274,8 @@ func (m *metricBigipPoolRequestCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricBigipPoolRequestCount(cfg MetricConfig) metricBigipPoolRequestCount {
-	m := metricBigipPoolRequestCount{config: cfg}
+func newMetricBigipSslProfileCipherCount(cfg MetricConfig) metricBigipSslProfileCipherCount {
+	m := metricBigipSslProfileCipherCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -962,50 +2283,50 @@ func newMetricBigipPoolRequestCount(cfg MetricConfig) metricBigipPoolRequestCoun
 	return m
 }
 
-type metricBigipPoolMemberAvailability struct {
+type metricBigipSslProfileConnectionsCurrent struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool_member.availability metric with initial data.
-func (m *metricBigipPoolMemberAvailability) init() {
-	m.data.SetName("bigip.pool_member.availability")
-	m.data.SetDescription("Availability of the pool member.")
-	m.data.SetUnit("1")
-	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+// init fills bigip.ssl.profile.connections.current metric with initial data.
+func (m *metricBigipSslProfileConnectionsCurrent) init() {
+	m.data.SetName("bigip.ssl.profile.connections.current")
+	m.data.SetDescription("Current number of connections handled by the client SSL profile.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
 }
 
-func (m *metricBigipPoolMemberAvailability) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityStatusAttributeValue string) {
+func (m *metricBigipSslProfileConnectionsCurrent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
-	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp := m.data.Sum().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("status", availabilityStatusAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolMemberAvailability) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricBigipSslProfileConnectionsCurrent) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolMemberAvailability) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricBigipSslProfileConnectionsCurrent) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricBigipPoolMemberAvailability(cfg MetricConfig) metricBigipPoolMemberAvailability {
-	m := metricBigipPoolMemberAvailability{config: cfg}
+func newMetricBigipSslProfileConnectionsCurrent(cfg MetricConfig) metricBigipSslProfileConnectionsCurrent {
+	m := metricBigipSslProfileConnectionsCurrent{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1013,23 +2334,24 @@ func newMetricBigipPoolMemberAvailability(cfg MetricConfig) metricBigipPoolMembe
 	return m
 }
 
-type metricBigipPoolMemberConnectionCount struct {
+type metricBigipSslProfileHandshakes struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool_member.connection.count metric with initial data.
-func (m *metricBigipPoolMemberConnectionCount) init() {
-	m.data.SetName("bigip.pool_member.connection.count")
-	m.data.SetDescription("Current number of connections to the pool member.")
-	m.data.SetUnit("{connections}")
+// init fills bigip.ssl.profile.handshakes metric with initial data.
+func (m *metricBigipSslProfileHandshakes) init() {
+	m.data.SetName("bigip.ssl.profile.handshakes")
+	m.data.SetDescription("Number of SSL/TLS handshakes handled by the client SSL profile.")
+	m.data.SetUnit("{handshakes}")
 	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolMemberConnectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricBigipSslProfileHandshakes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, handshakeResultAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1037,17 +2359,18 @@ func (m *metricBigipPoolMemberConnectionCount) recordDataPoint(start pcommon.Tim
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("result", handshakeResultAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolMemberConnectionCount) updateCapacity() {
+func (m *metricBigipSslProfileHandshakes) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolMemberConnectionCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipSslProfileHandshakes) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1055,8 +2378,8 @@ func (m *metricBigipPoolMemberConnectionCount) emit(metrics pmetric.MetricSlice)
 	}
 }
 
-func newMetricBigipPoolMemberConnectionCount(cfg MetricConfig) metricBigipPoolMemberConnectionCount {
-	m := metricBigipPoolMemberConnectionCount{config: cfg}
+func newMetricBigipSslProfileHandshakes(cfg MetricConfig) metricBigipSslProfileHandshakes {
+	m := metricBigipSslProfileHandshakes{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1064,24 +2387,24 @@ func newMetricBigipPoolMemberConnectionCount(cfg MetricConfig) metricBigipPoolMe
 	return m
 }
 
-type metricBigipPoolMemberDataTransmitted struct {
+type metricBigipSslProfileKeyExchangeCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool_member.data.transmitted metric with initial data.
-func (m *metricBigipPoolMemberDataTransmitted) init() {
-	m.data.SetName("bigip.pool_member.data.transmitted")
-	m.data.SetDescription("Amount of data transmitted to and from the pool member.")
-	m.data.SetUnit("By")
+// init fills bigip.ssl.profile.key_exchange.count metric with initial data.
+func (m *metricBigipSslProfileKeyExchangeCount) init() {
+	m.data.SetName("bigip.ssl.profile.key_exchange.count")
+	m.data.SetDescription("Number of connections negotiated with each key exchange algorithm by the client SSL profile.")
+	m.data.SetUnit("{connections}")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
 	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolMemberDataTransmitted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+func (m *metricBigipSslProfileKeyExchangeCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, sslKeyExchangeAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1089,18 +2412,18 @@ func (m *metricBigipPoolMemberDataTransmitted) recordDataPoint(start pcommon.Tim
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("direction", directionAttributeValue)
+	dp.Attributes().PutStr("key_exchange", sslKeyExchangeAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolMemberDataTransmitted) updateCapacity() {
+func (m *metricBigipSslProfileKeyExchangeCount) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolMemberDataTransmitted) emit(metrics pmetric.MetricSlice) {
+func (m *metricBigipSslProfileKeyExchangeCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1108,8 +2431,8 @@ func (m *metricBigipPoolMemberDataTransmitted) emit(metrics pmetric.MetricSlice)
 	}
 }
 
-func newMetricBigipPoolMemberDataTransmitted(cfg MetricConfig) metricBigipPoolMemberDataTransmitted {
-	m := metricBigipPoolMemberDataTransmitted{config: cfg}
+func newMetricBigipSslProfileKeyExchangeCount(cfg MetricConfig) metricBigipSslProfileKeyExchangeCount {
+	m := metricBigipSslProfileKeyExchangeCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1117,50 +2440,52 @@ func newMetricBigipPoolMemberDataTransmitted(cfg MetricConfig) metricBigipPoolMe
 	return m
 }
 
-type metricBigipPoolMemberEnabled struct {
+type metricBigipSslProfileProtocolCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool_member.enabled metric with initial data.
-func (m *metricBigipPoolMemberEnabled) init() {
-	m.data.SetName("bigip.pool_member.enabled")
-	m.data.SetDescription("Enabled state of of the pool member.")
-	m.data.SetUnit("1")
-	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+// init fills bigip.ssl.profile.protocol.count metric with initial data.
+func (m *metricBigipSslProfileProtocolCount) init() {
+	m.data.SetName("bigip.ssl.profile.protocol.count")
+	m.data.SetDescription("Number of connections negotiated with each protocol version by the client SSL profile.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolMemberEnabled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, enabledStatusAttributeValue string) {
+func (m *metricBigipSslProfileProtocolCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, sslProtocolAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
-	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp := m.data.Sum().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("status", enabledStatusAttributeValue)
+	dp.Attributes().PutStr("protocol", sslProtocolAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolMemberEnabled) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricBigipSslProfileProtocolCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolMemberEnabled) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricBigipSslProfileProtocolCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricBigipPoolMemberEnabled(cfg MetricConfig) metricBigipPoolMemberEnabled {
-	m := metricBigipPoolMemberEnabled{config: cfg}
+func newMetricBigipSslProfileProtocolCount(cfg MetricConfig) metricBigipSslProfileProtocolCount {
+	m := metricBigipSslProfileProtocolCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1168,52 +2493,50 @@ func newMetricBigipPoolMemberEnabled(cfg MetricConfig) metricBigipPoolMemberEnab
 	return m
 }
 
-type metricBigipPoolMemberPacketCount struct {
+type metricBigipSystemMemoryTotal struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool_member.packet.count metric with initial data.
-func (m *metricBigipPoolMemberPacketCount) init() {
-	m.data.SetName("bigip.pool_member.packet.count")
-	m.data.SetDescription("Number of packets transmitted to and from the pool member.")
-	m.data.SetUnit("{packets}")
-	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
-	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+// init fills bigip.system.memory.total metric with initial data.
+func (m *metricBigipSystemMemoryTotal) init() {
+	m.data.SetName("bigip.system.memory.total")
+	m.data.SetDescription("Total amount of memory available on the device.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolMemberPacketCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, directionAttributeValue string) {
+func (m *metricBigipSystemMemoryTotal) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, memoryTypeAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("direction", directionAttributeValue)
+	dp.Attributes().PutStr("memory.type", memoryTypeAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolMemberPacketCount) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricBigipSystemMemoryTotal) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolMemberPacketCount) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricBigipSystemMemoryTotal) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricBigipPoolMemberPacketCount(cfg MetricConfig) metricBigipPoolMemberPacketCount {
-	m := metricBigipPoolMemberPacketCount{config: cfg}
+func newMetricBigipSystemMemoryTotal(cfg MetricConfig) metricBigipSystemMemoryTotal {
+	m := metricBigipSystemMemoryTotal{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1221,50 +2544,50 @@ func newMetricBigipPoolMemberPacketCount(cfg MetricConfig) metricBigipPoolMember
 	return m
 }
 
-type metricBigipPoolMemberRequestCount struct {
+type metricBigipSystemMemoryUsed struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool_member.request.count metric with initial data.
-func (m *metricBigipPoolMemberRequestCount) init() {
-	m.data.SetName("bigip.pool_member.request.count")
-	m.data.SetDescription("Number of requests to the pool member.")
-	m.data.SetUnit("{requests}")
-	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+// init fills bigip.system.memory.used metric with initial data.
+func (m *metricBigipSystemMemoryUsed) init() {
+	m.data.SetName("bigip.system.memory.used")
+	m.data.SetDescription("Amount of memory in use on the device.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolMemberRequestCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricBigipSystemMemoryUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, memoryTypeAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("memory.type", memoryTypeAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolMemberRequestCount) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricBigipSystemMemoryUsed) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolMemberRequestCount) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricBigipSystemMemoryUsed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricBigipPoolMemberRequestCount(cfg MetricConfig) metricBigipPoolMemberRequestCount {
-	m := metricBigipPoolMemberRequestCount{config: cfg}
+func newMetricBigipSystemMemoryUsed(cfg MetricConfig) metricBigipSystemMemoryUsed {
+	m := metricBigipSystemMemoryUsed{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1272,50 +2595,50 @@ func newMetricBigipPoolMemberRequestCount(cfg MetricConfig) metricBigipPoolMembe
 	return m
 }
 
-type metricBigipPoolMemberSessionCount struct {
+type metricBigipSystemTmmCPUUtilization struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills bigip.pool_member.session.count metric with initial data.
-func (m *metricBigipPoolMemberSessionCount) init() {
-	m.data.SetName("bigip.pool_member.session.count")
-	m.data.SetDescription("Current number of sessions for the pool member.")
-	m.data.SetUnit("{sessions}")
-	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(false)
-	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+// init fills bigip.system.tmm.cpu.utilization metric with initial data.
+func (m *metricBigipSystemTmmCPUUtilization) init() {
+	m.data.SetName("bigip.system.tmm.cpu.utilization")
+	m.data.SetDescription("CPU utilization of the traffic management microkernel, averaged over the last five seconds.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricBigipPoolMemberSessionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricBigipSystemTmmCPUUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, tmmAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetIntValue(val)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("tmm", tmmAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricBigipPoolMemberSessionCount) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricBigipSystemTmmCPUUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricBigipPoolMemberSessionCount) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricBigipSystemTmmCPUUtilization) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricBigipPoolMemberSessionCount(cfg MetricConfig) metricBigipPoolMemberSessionCount {
-	m := metricBigipPoolMemberSessionCount{config: cfg}
+func newMetricBigipSystemTmmCPUUtilization(cfg MetricConfig) metricBigipSystemTmmCPUUtilization {
+	m := metricBigipSystemTmmCPUUtilization{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1636,40 +2959,61 @@ func newMetricBigipVirtualServerRequestCount(cfg MetricConfig) metricBigipVirtua
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                                  MetricsBuilderConfig // config of the metrics builder.
-	startTime                               pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                         int                  // maximum observed number of metrics per resource.
-	metricsBuffer                           pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                               component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter          map[string]filter.Filter
-	resourceAttributeExcludeFilter          map[string]filter.Filter
-	metricBigipNodeAvailability             metricBigipNodeAvailability
-	metricBigipNodeConnectionCount          metricBigipNodeConnectionCount
-	metricBigipNodeDataTransmitted          metricBigipNodeDataTransmitted
-	metricBigipNodeEnabled                  metricBigipNodeEnabled
-	metricBigipNodePacketCount              metricBigipNodePacketCount
-	metricBigipNodeRequestCount             metricBigipNodeRequestCount
-	metricBigipNodeSessionCount             metricBigipNodeSessionCount
-	metricBigipPoolAvailability             metricBigipPoolAvailability
-	metricBigipPoolConnectionCount          metricBigipPoolConnectionCount
-	metricBigipPoolDataTransmitted          metricBigipPoolDataTransmitted
-	metricBigipPoolEnabled                  metricBigipPoolEnabled
-	metricBigipPoolMemberCount              metricBigipPoolMemberCount
-	metricBigipPoolPacketCount              metricBigipPoolPacketCount
-	metricBigipPoolRequestCount             metricBigipPoolRequestCount
-	metricBigipPoolMemberAvailability       metricBigipPoolMemberAvailability
-	metricBigipPoolMemberConnectionCount    metricBigipPoolMemberConnectionCount
-	metricBigipPoolMemberDataTransmitted    metricBigipPoolMemberDataTransmitted
-	metricBigipPoolMemberEnabled            metricBigipPoolMemberEnabled
-	metricBigipPoolMemberPacketCount        metricBigipPoolMemberPacketCount
-	metricBigipPoolMemberRequestCount       metricBigipPoolMemberRequestCount
-	metricBigipPoolMemberSessionCount       metricBigipPoolMemberSessionCount
-	metricBigipVirtualServerAvailability    metricBigipVirtualServerAvailability
-	metricBigipVirtualServerConnectionCount metricBigipVirtualServerConnectionCount
-	metricBigipVirtualServerDataTransmitted metricBigipVirtualServerDataTransmitted
-	metricBigipVirtualServerEnabled         metricBigipVirtualServerEnabled
-	metricBigipVirtualServerPacketCount     metricBigipVirtualServerPacketCount
-	metricBigipVirtualServerRequestCount    metricBigipVirtualServerRequestCount
+	config                                     MetricsBuilderConfig // config of the metrics builder.
+	startTime                                  pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                            int                  // maximum observed number of metrics per resource.
+	metricsBuffer                              pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                  component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter             map[string]filter.Filter
+	resourceAttributeExcludeFilter             map[string]filter.Filter
+	metricBigipCmFailoverActive                metricBigipCmFailoverActive
+	metricBigipCmSyncStatus                    metricBigipCmSyncStatus
+	metricBigipDeviceConnectionCount           metricBigipDeviceConnectionCount
+	metricBigipDeviceDataTransmitted           metricBigipDeviceDataTransmitted
+	metricBigipDevicePacketCount               metricBigipDevicePacketCount
+	metricBigipHTTPResponses                   metricBigipHTTPResponses
+	metricBigipNetInterfaceBitsReceived        metricBigipNetInterfaceBitsReceived
+	metricBigipNetInterfaceBitsSent            metricBigipNetInterfaceBitsSent
+	metricBigipNetInterfaceDropsReceived       metricBigipNetInterfaceDropsReceived
+	metricBigipNetInterfaceDropsSent           metricBigipNetInterfaceDropsSent
+	metricBigipNodeAvailability                metricBigipNodeAvailability
+	metricBigipNodeConnectionCount             metricBigipNodeConnectionCount
+	metricBigipNodeDataTransmitted             metricBigipNodeDataTransmitted
+	metricBigipNodeEnabled                     metricBigipNodeEnabled
+	metricBigipNodePacketCount                 metricBigipNodePacketCount
+	metricBigipNodeRequestCount                metricBigipNodeRequestCount
+	metricBigipNodeSessionCount                metricBigipNodeSessionCount
+	metricBigipPoolAvailability                metricBigipPoolAvailability
+	metricBigipPoolConnectionCount             metricBigipPoolConnectionCount
+	metricBigipPoolDataTransmitted             metricBigipPoolDataTransmitted
+	metricBigipPoolEnabled                     metricBigipPoolEnabled
+	metricBigipPoolMemberCount                 metricBigipPoolMemberCount
+	metricBigipPoolPacketCount                 metricBigipPoolPacketCount
+	metricBigipPoolRequestCount                metricBigipPoolRequestCount
+	metricBigipPoolMemberAvailability          metricBigipPoolMemberAvailability
+	metricBigipPoolMemberConnectionCount       metricBigipPoolMemberConnectionCount
+	metricBigipPoolMemberDataTransmitted       metricBigipPoolMemberDataTransmitted
+	metricBigipPoolMemberEnabled               metricBigipPoolMemberEnabled
+	metricBigipPoolMemberMonitorStatus         metricBigipPoolMemberMonitorStatus
+	metricBigipPoolMemberPacketCount           metricBigipPoolMemberPacketCount
+	metricBigipPoolMemberRequestCount          metricBigipPoolMemberRequestCount
+	metricBigipPoolMemberSessionCount          metricBigipPoolMemberSessionCount
+	metricBigipSnatpoolConnections             metricBigipSnatpoolConnections
+	metricBigipSnatpoolTranslationAddressCount metricBigipSnatpoolTranslationAddressCount
+	metricBigipSslProfileCipherCount           metricBigipSslProfileCipherCount
+	metricBigipSslProfileConnectionsCurrent    metricBigipSslProfileConnectionsCurrent
+	metricBigipSslProfileHandshakes            metricBigipSslProfileHandshakes
+	metricBigipSslProfileKeyExchangeCount      metricBigipSslProfileKeyExchangeCount
+	metricBigipSslProfileProtocolCount         metricBigipSslProfileProtocolCount
+	metricBigipSystemMemoryTotal               metricBigipSystemMemoryTotal
+	metricBigipSystemMemoryUsed                metricBigipSystemMemoryUsed
+	metricBigipSystemTmmCPUUtilization         metricBigipSystemTmmCPUUtilization
+	metricBigipVirtualServerAvailability       metricBigipVirtualServerAvailability
+	metricBigipVirtualServerConnectionCount    metricBigipVirtualServerConnectionCount
+	metricBigipVirtualServerDataTransmitted    metricBigipVirtualServerDataTransmitted
+	metricBigipVirtualServerEnabled            metricBigipVirtualServerEnabled
+	metricBigipVirtualServerPacketCount        metricBigipVirtualServerPacketCount
+	metricBigipVirtualServerRequestCount       metricBigipVirtualServerRequestCount
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -1691,39 +3035,72 @@ func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
 }
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                                  mbc,
-		startTime:                               pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                           pmetric.NewMetrics(),
-		buildInfo:                               settings.BuildInfo,
-		metricBigipNodeAvailability:             newMetricBigipNodeAvailability(mbc.Metrics.BigipNodeAvailability),
-		metricBigipNodeConnectionCount:          newMetricBigipNodeConnectionCount(mbc.Metrics.BigipNodeConnectionCount),
-		metricBigipNodeDataTransmitted:          newMetricBigipNodeDataTransmitted(mbc.Metrics.BigipNodeDataTransmitted),
-		metricBigipNodeEnabled:                  newMetricBigipNodeEnabled(mbc.Metrics.BigipNodeEnabled),
-		metricBigipNodePacketCount:              newMetricBigipNodePacketCount(mbc.Metrics.BigipNodePacketCount),
-		metricBigipNodeRequestCount:             newMetricBigipNodeRequestCount(mbc.Metrics.BigipNodeRequestCount),
-		metricBigipNodeSessionCount:             newMetricBigipNodeSessionCount(mbc.Metrics.BigipNodeSessionCount),
-		metricBigipPoolAvailability:             newMetricBigipPoolAvailability(mbc.Metrics.BigipPoolAvailability),
-		metricBigipPoolConnectionCount:          newMetricBigipPoolConnectionCount(mbc.Metrics.BigipPoolConnectionCount),
-		metricBigipPoolDataTransmitted:          newMetricBigipPoolDataTransmitted(mbc.Metrics.BigipPoolDataTransmitted),
-		metricBigipPoolEnabled:                  newMetricBigipPoolEnabled(mbc.Metrics.BigipPoolEnabled),
-		metricBigipPoolMemberCount:              newMetricBigipPoolMemberCount(mbc.Metrics.BigipPoolMemberCount),
-		metricBigipPoolPacketCount:              newMetricBigipPoolPacketCount(mbc.Metrics.BigipPoolPacketCount),
-		metricBigipPoolRequestCount:             newMetricBigipPoolRequestCount(mbc.Metrics.BigipPoolRequestCount),
-		metricBigipPoolMemberAvailability:       newMetricBigipPoolMemberAvailability(mbc.Metrics.BigipPoolMemberAvailability),
-		metricBigipPoolMemberConnectionCount:    newMetricBigipPoolMemberConnectionCount(mbc.Metrics.BigipPoolMemberConnectionCount),
-		metricBigipPoolMemberDataTransmitted:    newMetricBigipPoolMemberDataTransmitted(mbc.Metrics.BigipPoolMemberDataTransmitted),
-		metricBigipPoolMemberEnabled:            newMetricBigipPoolMemberEnabled(mbc.Metrics.BigipPoolMemberEnabled),
-		metricBigipPoolMemberPacketCount:        newMetricBigipPoolMemberPacketCount(mbc.Metrics.BigipPoolMemberPacketCount),
-		metricBigipPoolMemberRequestCount:       newMetricBigipPoolMemberRequestCount(mbc.Metrics.BigipPoolMemberRequestCount),
-		metricBigipPoolMemberSessionCount:       newMetricBigipPoolMemberSessionCount(mbc.Metrics.BigipPoolMemberSessionCount),
-		metricBigipVirtualServerAvailability:    newMetricBigipVirtualServerAvailability(mbc.Metrics.BigipVirtualServerAvailability),
-		metricBigipVirtualServerConnectionCount: newMetricBigipVirtualServerConnectionCount(mbc.Metrics.BigipVirtualServerConnectionCount),
-		metricBigipVirtualServerDataTransmitted: newMetricBigipVirtualServerDataTransmitted(mbc.Metrics.BigipVirtualServerDataTransmitted),
-		metricBigipVirtualServerEnabled:         newMetricBigipVirtualServerEnabled(mbc.Metrics.BigipVirtualServerEnabled),
-		metricBigipVirtualServerPacketCount:     newMetricBigipVirtualServerPacketCount(mbc.Metrics.BigipVirtualServerPacketCount),
-		metricBigipVirtualServerRequestCount:    newMetricBigipVirtualServerRequestCount(mbc.Metrics.BigipVirtualServerRequestCount),
-		resourceAttributeIncludeFilter:          make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:          make(map[string]filter.Filter),
+		config:                                     mbc,
+		startTime:                                  pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                              pmetric.NewMetrics(),
+		buildInfo:                                  settings.BuildInfo,
+		metricBigipCmFailoverActive:                newMetricBigipCmFailoverActive(mbc.Metrics.BigipCmFailoverActive),
+		metricBigipCmSyncStatus:                    newMetricBigipCmSyncStatus(mbc.Metrics.BigipCmSyncStatus),
+		metricBigipDeviceConnectionCount:           newMetricBigipDeviceConnectionCount(mbc.Metrics.BigipDeviceConnectionCount),
+		metricBigipDeviceDataTransmitted:           newMetricBigipDeviceDataTransmitted(mbc.Metrics.BigipDeviceDataTransmitted),
+		metricBigipDevicePacketCount:               newMetricBigipDevicePacketCount(mbc.Metrics.BigipDevicePacketCount),
+		metricBigipHTTPResponses:                   newMetricBigipHTTPResponses(mbc.Metrics.BigipHTTPResponses),
+		metricBigipNetInterfaceBitsReceived:        newMetricBigipNetInterfaceBitsReceived(mbc.Metrics.BigipNetInterfaceBitsReceived),
+		metricBigipNetInterfaceBitsSent:            newMetricBigipNetInterfaceBitsSent(mbc.Metrics.BigipNetInterfaceBitsSent),
+		metricBigipNetInterfaceDropsReceived:       newMetricBigipNetInterfaceDropsReceived(mbc.Metrics.BigipNetInterfaceDropsReceived),
+		metricBigipNetInterfaceDropsSent:           newMetricBigipNetInterfaceDropsSent(mbc.Metrics.BigipNetInterfaceDropsSent),
+		metricBigipNodeAvailability:                newMetricBigipNodeAvailability(mbc.Metrics.BigipNodeAvailability),
+		metricBigipNodeConnectionCount:             newMetricBigipNodeConnectionCount(mbc.Metrics.BigipNodeConnectionCount),
+		metricBigipNodeDataTransmitted:             newMetricBigipNodeDataTransmitted(mbc.Metrics.BigipNodeDataTransmitted),
+		metricBigipNodeEnabled:                     newMetricBigipNodeEnabled(mbc.Metrics.BigipNodeEnabled),
+		metricBigipNodePacketCount:                 newMetricBigipNodePacketCount(mbc.Metrics.BigipNodePacketCount),
+		metricBigipNodeRequestCount:                newMetricBigipNodeRequestCount(mbc.Metrics.BigipNodeRequestCount),
+		metricBigipNodeSessionCount:                newMetricBigipNodeSessionCount(mbc.Metrics.BigipNodeSessionCount),
+		metricBigipPoolAvailability:                newMetricBigipPoolAvailability(mbc.Metrics.BigipPoolAvailability),
+		metricBigipPoolConnectionCount:             newMetricBigipPoolConnectionCount(mbc.Metrics.BigipPoolConnectionCount),
+		metricBigipPoolDataTransmitted:             newMetricBigipPoolDataTransmitted(mbc.Metrics.BigipPoolDataTransmitted),
+		metricBigipPoolEnabled:                     newMetricBigipPoolEnabled(mbc.Metrics.BigipPoolEnabled),
+		metricBigipPoolMemberCount:                 newMetricBigipPoolMemberCount(mbc.Metrics.BigipPoolMemberCount),
+		metricBigipPoolPacketCount:                 newMetricBigipPoolPacketCount(mbc.Metrics.BigipPoolPacketCount),
+		metricBigipPoolRequestCount:                newMetricBigipPoolRequestCount(mbc.Metrics.BigipPoolRequestCount),
+		metricBigipPoolMemberAvailability:          newMetricBigipPoolMemberAvailability(mbc.Metrics.BigipPoolMemberAvailability),
+		metricBigipPoolMemberConnectionCount:       newMetricBigipPoolMemberConnectionCount(mbc.Metrics.BigipPoolMemberConnectionCount),
+		metricBigipPoolMemberDataTransmitted:       newMetricBigipPoolMemberDataTransmitted(mbc.Metrics.BigipPoolMemberDataTransmitted),
+		metricBigipPoolMemberEnabled:               newMetricBigipPoolMemberEnabled(mbc.Metrics.BigipPoolMemberEnabled),
+		metricBigipPoolMemberMonitorStatus:         newMetricBigipPoolMemberMonitorStatus(mbc.Metrics.BigipPoolMemberMonitorStatus),
+		metricBigipPoolMemberPacketCount:           newMetricBigipPoolMemberPacketCount(mbc.Metrics.BigipPoolMemberPacketCount),
+		metricBigipPoolMemberRequestCount:          newMetricBigipPoolMemberRequestCount(mbc.Metrics.BigipPoolMemberRequestCount),
+		metricBigipPoolMemberSessionCount:          newMetricBigipPoolMemberSessionCount(mbc.Metrics.BigipPoolMemberSessionCount),
+		metricBigipSnatpoolConnections:             newMetricBigipSnatpoolConnections(mbc.Metrics.BigipSnatpoolConnections),
+		metricBigipSnatpoolTranslationAddressCount: newMetricBigipSnatpoolTranslationAddressCount(mbc.Metrics.BigipSnatpoolTranslationAddressCount),
+		metricBigipSslProfileCipherCount:           newMetricBigipSslProfileCipherCount(mbc.Metrics.BigipSslProfileCipherCount),
+		metricBigipSslProfileConnectionsCurrent:    newMetricBigipSslProfileConnectionsCurrent(mbc.Metrics.BigipSslProfileConnectionsCurrent),
+		metricBigipSslProfileHandshakes:            newMetricBigipSslProfileHandshakes(mbc.Metrics.BigipSslProfileHandshakes),
+		metricBigipSslProfileKeyExchangeCount:      newMetricBigipSslProfileKeyExchangeCount(mbc.Metrics.BigipSslProfileKeyExchangeCount),
+		metricBigipSslProfileProtocolCount:         newMetricBigipSslProfileProtocolCount(mbc.Metrics.BigipSslProfileProtocolCount),
+		metricBigipSystemMemoryTotal:               newMetricBigipSystemMemoryTotal(mbc.Metrics.BigipSystemMemoryTotal),
+		metricBigipSystemMemoryUsed:                newMetricBigipSystemMemoryUsed(mbc.Metrics.BigipSystemMemoryUsed),
+		metricBigipSystemTmmCPUUtilization:         newMetricBigipSystemTmmCPUUtilization(mbc.Metrics.BigipSystemTmmCPUUtilization),
+		metricBigipVirtualServerAvailability:       newMetricBigipVirtualServerAvailability(mbc.Metrics.BigipVirtualServerAvailability),
+		metricBigipVirtualServerConnectionCount:    newMetricBigipVirtualServerConnectionCount(mbc.Metrics.BigipVirtualServerConnectionCount),
+		metricBigipVirtualServerDataTransmitted:    newMetricBigipVirtualServerDataTransmitted(mbc.Metrics.BigipVirtualServerDataTransmitted),
+		metricBigipVirtualServerEnabled:            newMetricBigipVirtualServerEnabled(mbc.Metrics.BigipVirtualServerEnabled),
+		metricBigipVirtualServerPacketCount:        newMetricBigipVirtualServerPacketCount(mbc.Metrics.BigipVirtualServerPacketCount),
+		metricBigipVirtualServerRequestCount:       newMetricBigipVirtualServerRequestCount(mbc.Metrics.BigipVirtualServerRequestCount),
+		resourceAttributeIncludeFilter:             make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:             make(map[string]filter.Filter),
+	}
+	if mbc.ResourceAttributes.BigipDeviceEndpoint.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["bigip.device.endpoint"] = filter.CreateFilter(mbc.ResourceAttributes.BigipDeviceEndpoint.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.BigipDeviceEndpoint.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["bigip.device.endpoint"] = filter.CreateFilter(mbc.ResourceAttributes.BigipDeviceEndpoint.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.BigipHTTPProfileName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["bigip.http.profile.name"] = filter.CreateFilter(mbc.ResourceAttributes.BigipHTTPProfileName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.BigipHTTPProfileName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["bigip.http.profile.name"] = filter.CreateFilter(mbc.ResourceAttributes.BigipHTTPProfileName.MetricsExclude)
 	}
 	if mbc.ResourceAttributes.BigipNodeIPAddress.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["bigip.node.ip_address"] = filter.CreateFilter(mbc.ResourceAttributes.BigipNodeIPAddress.MetricsInclude)
@@ -1755,6 +3132,12 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 	if mbc.ResourceAttributes.BigipPoolMemberName.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["bigip.pool_member.name"] = filter.CreateFilter(mbc.ResourceAttributes.BigipPoolMemberName.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.BigipSslProfileName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["bigip.ssl.profile.name"] = filter.CreateFilter(mbc.ResourceAttributes.BigipSslProfileName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.BigipSslProfileName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["bigip.ssl.profile.name"] = filter.CreateFilter(mbc.ResourceAttributes.BigipSslProfileName.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.BigipVirtualServerDestination.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["bigip.virtual_server.destination"] = filter.CreateFilter(mbc.ResourceAttributes.BigipVirtualServerDestination.MetricsInclude)
 	}
@@ -1836,6 +3219,16 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	ils.Scope().SetName(ScopeName)
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricBigipCmFailoverActive.emit(ils.Metrics())
+	mb.metricBigipCmSyncStatus.emit(ils.Metrics())
+	mb.metricBigipDeviceConnectionCount.emit(ils.Metrics())
+	mb.metricBigipDeviceDataTransmitted.emit(ils.Metrics())
+	mb.metricBigipDevicePacketCount.emit(ils.Metrics())
+	mb.metricBigipHTTPResponses.emit(ils.Metrics())
+	mb.metricBigipNetInterfaceBitsReceived.emit(ils.Metrics())
+	mb.metricBigipNetInterfaceBitsSent.emit(ils.Metrics())
+	mb.metricBigipNetInterfaceDropsReceived.emit(ils.Metrics())
+	mb.metricBigipNetInterfaceDropsSent.emit(ils.Metrics())
 	mb.metricBigipNodeAvailability.emit(ils.Metrics())
 	mb.metricBigipNodeConnectionCount.emit(ils.Metrics())
 	mb.metricBigipNodeDataTransmitted.emit(ils.Metrics())
@@ -1854,9 +3247,20 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricBigipPoolMemberConnectionCount.emit(ils.Metrics())
 	mb.metricBigipPoolMemberDataTransmitted.emit(ils.Metrics())
 	mb.metricBigipPoolMemberEnabled.emit(ils.Metrics())
+	mb.metricBigipPoolMemberMonitorStatus.emit(ils.Metrics())
 	mb.metricBigipPoolMemberPacketCount.emit(ils.Metrics())
 	mb.metricBigipPoolMemberRequestCount.emit(ils.Metrics())
 	mb.metricBigipPoolMemberSessionCount.emit(ils.Metrics())
+	mb.metricBigipSnatpoolConnections.emit(ils.Metrics())
+	mb.metricBigipSnatpoolTranslationAddressCount.emit(ils.Metrics())
+	mb.metricBigipSslProfileCipherCount.emit(ils.Metrics())
+	mb.metricBigipSslProfileConnectionsCurrent.emit(ils.Metrics())
+	mb.metricBigipSslProfileHandshakes.emit(ils.Metrics())
+	mb.metricBigipSslProfileKeyExchangeCount.emit(ils.Metrics())
+	mb.metricBigipSslProfileProtocolCount.emit(ils.Metrics())
+	mb.metricBigipSystemMemoryTotal.emit(ils.Metrics())
+	mb.metricBigipSystemMemoryUsed.emit(ils.Metrics())
+	mb.metricBigipSystemTmmCPUUtilization.emit(ils.Metrics())
 	mb.metricBigipVirtualServerAvailability.emit(ils.Metrics())
 	mb.metricBigipVirtualServerConnectionCount.emit(ils.Metrics())
 	mb.metricBigipVirtualServerDataTransmitted.emit(ils.Metrics())
@@ -1894,6 +3298,56 @@ func (mb *MetricsBuilder) Emit(options ...ResourceMetricsOption) pmetric.Metrics
 	return metrics
 }
 
+// RecordBigipCmFailoverActiveDataPoint adds a data point to bigip.cm.failover.active metric.
+func (mb *MetricsBuilder) RecordBigipCmFailoverActiveDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string) {
+	mb.metricBigipCmFailoverActive.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue)
+}
+
+// RecordBigipCmSyncStatusDataPoint adds a data point to bigip.cm.sync.status metric.
+func (mb *MetricsBuilder) RecordBigipCmSyncStatusDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, syncStatusAttributeValue AttributeSyncStatus) {
+	mb.metricBigipCmSyncStatus.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, syncStatusAttributeValue.String())
+}
+
+// RecordBigipDeviceConnectionCountDataPoint adds a data point to bigip.device.connection.count metric.
+func (mb *MetricsBuilder) RecordBigipDeviceConnectionCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricBigipDeviceConnectionCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordBigipDeviceDataTransmittedDataPoint adds a data point to bigip.device.data.transmitted metric.
+func (mb *MetricsBuilder) RecordBigipDeviceDataTransmittedDataPoint(ts pcommon.Timestamp, val int64, directionAttributeValue AttributeDirection) {
+	mb.metricBigipDeviceDataTransmitted.recordDataPoint(mb.startTime, ts, val, directionAttributeValue.String())
+}
+
+// RecordBigipDevicePacketCountDataPoint adds a data point to bigip.device.packet.count metric.
+func (mb *MetricsBuilder) RecordBigipDevicePacketCountDataPoint(ts pcommon.Timestamp, val int64, directionAttributeValue AttributeDirection) {
+	mb.metricBigipDevicePacketCount.recordDataPoint(mb.startTime, ts, val, directionAttributeValue.String())
+}
+
+// RecordBigipHTTPResponsesDataPoint adds a data point to bigip.http.responses metric.
+func (mb *MetricsBuilder) RecordBigipHTTPResponsesDataPoint(ts pcommon.Timestamp, val int64, codeClassAttributeValue AttributeCodeClass) {
+	mb.metricBigipHTTPResponses.recordDataPoint(mb.startTime, ts, val, codeClassAttributeValue.String())
+}
+
+// RecordBigipNetInterfaceBitsReceivedDataPoint adds a data point to bigip.net.interface.bits.received metric.
+func (mb *MetricsBuilder) RecordBigipNetInterfaceBitsReceivedDataPoint(ts pcommon.Timestamp, val int64, deviceInterfaceAttributeValue string) {
+	mb.metricBigipNetInterfaceBitsReceived.recordDataPoint(mb.startTime, ts, val, deviceInterfaceAttributeValue)
+}
+
+// RecordBigipNetInterfaceBitsSentDataPoint adds a data point to bigip.net.interface.bits.sent metric.
+func (mb *MetricsBuilder) RecordBigipNetInterfaceBitsSentDataPoint(ts pcommon.Timestamp, val int64, deviceInterfaceAttributeValue string) {
+	mb.metricBigipNetInterfaceBitsSent.recordDataPoint(mb.startTime, ts, val, deviceInterfaceAttributeValue)
+}
+
+// RecordBigipNetInterfaceDropsReceivedDataPoint adds a data point to bigip.net.interface.drops.received metric.
+func (mb *MetricsBuilder) RecordBigipNetInterfaceDropsReceivedDataPoint(ts pcommon.Timestamp, val int64, deviceInterfaceAttributeValue string) {
+	mb.metricBigipNetInterfaceDropsReceived.recordDataPoint(mb.startTime, ts, val, deviceInterfaceAttributeValue)
+}
+
+// RecordBigipNetInterfaceDropsSentDataPoint adds a data point to bigip.net.interface.drops.sent metric.
+func (mb *MetricsBuilder) RecordBigipNetInterfaceDropsSentDataPoint(ts pcommon.Timestamp, val int64, deviceInterfaceAttributeValue string) {
+	mb.metricBigipNetInterfaceDropsSent.recordDataPoint(mb.startTime, ts, val, deviceInterfaceAttributeValue)
+}
+
 // RecordBigipNodeAvailabilityDataPoint adds a data point to bigip.node.availability metric.
 func (mb *MetricsBuilder) RecordBigipNodeAvailabilityDataPoint(ts pcommon.Timestamp, val int64, availabilityStatusAttributeValue AttributeAvailabilityStatus) {
 	mb.metricBigipNodeAvailability.recordDataPoint(mb.startTime, ts, val, availabilityStatusAttributeValue.String())
@@ -1984,6 +3438,11 @@ func (mb *MetricsBuilder) RecordBigipPoolMemberEnabledDataPoint(ts pcommon.Times
 	mb.metricBigipPoolMemberEnabled.recordDataPoint(mb.startTime, ts, val, enabledStatusAttributeValue.String())
 }
 
+// RecordBigipPoolMemberMonitorStatusDataPoint adds a data point to bigip.pool_member.monitor.status metric.
+func (mb *MetricsBuilder) RecordBigipPoolMemberMonitorStatusDataPoint(ts pcommon.Timestamp, val int64, availabilityStatusAttributeValue AttributeAvailabilityStatus, statusReasonAttributeValue string) {
+	mb.metricBigipPoolMemberMonitorStatus.recordDataPoint(mb.startTime, ts, val, availabilityStatusAttributeValue.String(), statusReasonAttributeValue)
+}
+
 // RecordBigipPoolMemberPacketCountDataPoint adds a data point to bigip.pool_member.packet.count metric.
 func (mb *MetricsBuilder) RecordBigipPoolMemberPacketCountDataPoint(ts pcommon.Timestamp, val int64, directionAttributeValue AttributeDirection) {
 	mb.metricBigipPoolMemberPacketCount.recordDataPoint(mb.startTime, ts, val, directionAttributeValue.String())
@@ -1999,6 +3458,56 @@ func (mb *MetricsBuilder) RecordBigipPoolMemberSessionCountDataPoint(ts pcommon.
 	mb.metricBigipPoolMemberSessionCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordBigipSnatpoolConnectionsDataPoint adds a data point to bigip.snatpool.connections metric.
+func (mb *MetricsBuilder) RecordBigipSnatpoolConnectionsDataPoint(ts pcommon.Timestamp, val int64, snatpoolNameAttributeValue string) {
+	mb.metricBigipSnatpoolConnections.recordDataPoint(mb.startTime, ts, val, snatpoolNameAttributeValue)
+}
+
+// RecordBigipSnatpoolTranslationAddressCountDataPoint adds a data point to bigip.snatpool.translation_address.count metric.
+func (mb *MetricsBuilder) RecordBigipSnatpoolTranslationAddressCountDataPoint(ts pcommon.Timestamp, val int64, snatpoolNameAttributeValue string) {
+	mb.metricBigipSnatpoolTranslationAddressCount.recordDataPoint(mb.startTime, ts, val, snatpoolNameAttributeValue)
+}
+
+// RecordBigipSslProfileCipherCountDataPoint adds a data point to bigip.ssl.profile.cipher.count metric.
+func (mb *MetricsBuilder) RecordBigipSslProfileCipherCountDataPoint(ts pcommon.Timestamp, val int64, sslCipherAttributeValue string) {
+	mb.metricBigipSslProfileCipherCount.recordDataPoint(mb.startTime, ts, val, sslCipherAttributeValue)
+}
+
+// RecordBigipSslProfileConnectionsCurrentDataPoint adds a data point to bigip.ssl.profile.connections.current metric.
+func (mb *MetricsBuilder) RecordBigipSslProfileConnectionsCurrentDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricBigipSslProfileConnectionsCurrent.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordBigipSslProfileHandshakesDataPoint adds a data point to bigip.ssl.profile.handshakes metric.
+func (mb *MetricsBuilder) RecordBigipSslProfileHandshakesDataPoint(ts pcommon.Timestamp, val int64, handshakeResultAttributeValue AttributeHandshakeResult) {
+	mb.metricBigipSslProfileHandshakes.recordDataPoint(mb.startTime, ts, val, handshakeResultAttributeValue.String())
+}
+
+// RecordBigipSslProfileKeyExchangeCountDataPoint adds a data point to bigip.ssl.profile.key_exchange.count metric.
+func (mb *MetricsBuilder) RecordBigipSslProfileKeyExchangeCountDataPoint(ts pcommon.Timestamp, val int64, sslKeyExchangeAttributeValue string) {
+	mb.metricBigipSslProfileKeyExchangeCount.recordDataPoint(mb.startTime, ts, val, sslKeyExchangeAttributeValue)
+}
+
+// RecordBigipSslProfileProtocolCountDataPoint adds a data point to bigip.ssl.profile.protocol.count metric.
+func (mb *MetricsBuilder) RecordBigipSslProfileProtocolCountDataPoint(ts pcommon.Timestamp, val int64, sslProtocolAttributeValue string) {
+	mb.metricBigipSslProfileProtocolCount.recordDataPoint(mb.startTime, ts, val, sslProtocolAttributeValue)
+}
+
+// RecordBigipSystemMemoryTotalDataPoint adds a data point to bigip.system.memory.total metric.
+func (mb *MetricsBuilder) RecordBigipSystemMemoryTotalDataPoint(ts pcommon.Timestamp, val int64, memoryTypeAttributeValue AttributeMemoryType) {
+	mb.metricBigipSystemMemoryTotal.recordDataPoint(mb.startTime, ts, val, memoryTypeAttributeValue.String())
+}
+
+// RecordBigipSystemMemoryUsedDataPoint adds a data point to bigip.system.memory.used metric.
+func (mb *MetricsBuilder) RecordBigipSystemMemoryUsedDataPoint(ts pcommon.Timestamp, val int64, memoryTypeAttributeValue AttributeMemoryType) {
+	mb.metricBigipSystemMemoryUsed.recordDataPoint(mb.startTime, ts, val, memoryTypeAttributeValue.String())
+}
+
+// RecordBigipSystemTmmCPUUtilizationDataPoint adds a data point to bigip.system.tmm.cpu.utilization metric.
+func (mb *MetricsBuilder) RecordBigipSystemTmmCPUUtilizationDataPoint(ts pcommon.Timestamp, val float64, tmmAttributeValue string) {
+	mb.metricBigipSystemTmmCPUUtilization.recordDataPoint(mb.startTime, ts, val, tmmAttributeValue)
+}
+
 // RecordBigipVirtualServerAvailabilityDataPoint adds a data point to bigip.virtual_server.availability metric.
 func (mb *MetricsBuilder) RecordBigipVirtualServerAvailabilityDataPoint(ts pcommon.Timestamp, val int64, availabilityStatusAttributeValue AttributeAvailabilityStatus) {
 	mb.metricBigipVirtualServerAvailability.recordDataPoint(mb.startTime, ts, val, availabilityStatusAttributeValue.String())
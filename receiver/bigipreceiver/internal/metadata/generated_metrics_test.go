@@ -68,6 +68,36 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			allMetricsCount++
+			mb.RecordBigipCmFailoverActiveDataPoint(ts, 1, "device-val")
+
+			allMetricsCount++
+			mb.RecordBigipCmSyncStatusDataPoint(ts, 1, "device-val", AttributeSyncStatusInSync)
+
+			allMetricsCount++
+			mb.RecordBigipDeviceConnectionCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordBigipDeviceDataTransmittedDataPoint(ts, 1, AttributeDirectionSent)
+
+			allMetricsCount++
+			mb.RecordBigipDevicePacketCountDataPoint(ts, 1, AttributeDirectionSent)
+
+			allMetricsCount++
+			mb.RecordBigipHTTPResponsesDataPoint(ts, 1, AttributeCodeClass2xx)
+
+			allMetricsCount++
+			mb.RecordBigipNetInterfaceBitsReceivedDataPoint(ts, 1, "device.interface-val")
+
+			allMetricsCount++
+			mb.RecordBigipNetInterfaceBitsSentDataPoint(ts, 1, "device.interface-val")
+
+			allMetricsCount++
+			mb.RecordBigipNetInterfaceDropsReceivedDataPoint(ts, 1, "device.interface-val")
+
+			allMetricsCount++
+			mb.RecordBigipNetInterfaceDropsSentDataPoint(ts, 1, "device.interface-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordBigipNodeAvailabilityDataPoint(ts, 1, AttributeAvailabilityStatusOffline)
@@ -140,6 +170,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordBigipPoolMemberEnabledDataPoint(ts, 1, AttributeEnabledStatusDisabled)
 
+			allMetricsCount++
+			mb.RecordBigipPoolMemberMonitorStatusDataPoint(ts, 1, AttributeAvailabilityStatusOffline, "status.reason-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordBigipPoolMemberPacketCountDataPoint(ts, 1, AttributeDirectionSent)
@@ -152,6 +185,36 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordBigipPoolMemberSessionCountDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordBigipSnatpoolConnectionsDataPoint(ts, 1, "snatpool.name-val")
+
+			allMetricsCount++
+			mb.RecordBigipSnatpoolTranslationAddressCountDataPoint(ts, 1, "snatpool.name-val")
+
+			allMetricsCount++
+			mb.RecordBigipSslProfileCipherCountDataPoint(ts, 1, "ssl.cipher-val")
+
+			allMetricsCount++
+			mb.RecordBigipSslProfileConnectionsCurrentDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordBigipSslProfileHandshakesDataPoint(ts, 1, AttributeHandshakeResultSuccess)
+
+			allMetricsCount++
+			mb.RecordBigipSslProfileKeyExchangeCountDataPoint(ts, 1, "ssl.key_exchange-val")
+
+			allMetricsCount++
+			mb.RecordBigipSslProfileProtocolCountDataPoint(ts, 1, "ssl.protocol-val")
+
+			allMetricsCount++
+			mb.RecordBigipSystemMemoryTotalDataPoint(ts, 1, AttributeMemoryTypeTmm)
+
+			allMetricsCount++
+			mb.RecordBigipSystemMemoryUsedDataPoint(ts, 1, AttributeMemoryTypeTmm)
+
+			allMetricsCount++
+			mb.RecordBigipSystemTmmCPUUtilizationDataPoint(ts, 1, "tmm-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordBigipVirtualServerAvailabilityDataPoint(ts, 1, AttributeAvailabilityStatusOffline)
@@ -177,11 +240,14 @@ func TestMetricsBuilder(t *testing.T) {
 			mb.RecordBigipVirtualServerRequestCountDataPoint(ts, 1)
 
 			rb := mb.NewResourceBuilder()
+			rb.SetBigipDeviceEndpoint("bigip.device.endpoint-val")
+			rb.SetBigipHTTPProfileName("bigip.http.profile.name-val")
 			rb.SetBigipNodeIPAddress("bigip.node.ip_address-val")
 			rb.SetBigipNodeName("bigip.node.name-val")
 			rb.SetBigipPoolName("bigip.pool.name-val")
 			rb.SetBigipPoolMemberIPAddress("bigip.pool_member.ip_address-val")
 			rb.SetBigipPoolMemberName("bigip.pool_member.name-val")
+			rb.SetBigipSslProfileName("bigip.ssl.profile.name-val")
 			rb.SetBigipVirtualServerDestination("bigip.virtual_server.destination-val")
 			rb.SetBigipVirtualServerName("bigip.virtual_server.name-val")
 			res := rb.Emit()
@@ -206,6 +272,172 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
+				case "bigip.cm.failover.active":
+					assert.False(t, validatedMetrics["bigip.cm.failover.active"], "Found a duplicate in the metrics slice: bigip.cm.failover.active")
+					validatedMetrics["bigip.cm.failover.active"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the device is the active unit of its failover group, 1 when active and 0 otherwise.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.Equal(t, "device-val", attrVal.Str())
+				case "bigip.cm.sync.status":
+					assert.False(t, validatedMetrics["bigip.cm.sync.status"], "Found a duplicate in the metrics slice: bigip.cm.sync.status")
+					validatedMetrics["bigip.cm.sync.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Config sync status of the device.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device")
+					assert.True(t, ok)
+					assert.Equal(t, "device-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("status")
+					assert.True(t, ok)
+					assert.Equal(t, "in_sync", attrVal.Str())
+				case "bigip.device.connection.count":
+					assert.False(t, validatedMetrics["bigip.device.connection.count"], "Found a duplicate in the metrics slice: bigip.device.connection.count")
+					validatedMetrics["bigip.device.connection.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Current number of client side connections to the device.", ms.At(i).Description())
+					assert.Equal(t, "{connections}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "bigip.device.data.transmitted":
+					assert.False(t, validatedMetrics["bigip.device.data.transmitted"], "Found a duplicate in the metrics slice: bigip.device.data.transmitted")
+					validatedMetrics["bigip.device.data.transmitted"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Amount of client side data transmitted to and from the device.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.Equal(t, "sent", attrVal.Str())
+				case "bigip.device.packet.count":
+					assert.False(t, validatedMetrics["bigip.device.packet.count"], "Found a duplicate in the metrics slice: bigip.device.packet.count")
+					validatedMetrics["bigip.device.packet.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of client side packets transmitted to and from the device.", ms.At(i).Description())
+					assert.Equal(t, "{packets}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.Equal(t, "sent", attrVal.Str())
+				case "bigip.http.responses":
+					assert.False(t, validatedMetrics["bigip.http.responses"], "Found a duplicate in the metrics slice: bigip.http.responses")
+					validatedMetrics["bigip.http.responses"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of HTTP responses sent by the virtual servers using the HTTP profile.", ms.At(i).Description())
+					assert.Equal(t, "{responses}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("code.class")
+					assert.True(t, ok)
+					assert.Equal(t, "2xx", attrVal.Str())
+				case "bigip.net.interface.bits.received":
+					assert.False(t, validatedMetrics["bigip.net.interface.bits.received"], "Found a duplicate in the metrics slice: bigip.net.interface.bits.received")
+					validatedMetrics["bigip.net.interface.bits.received"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of bits received by the network interface.", ms.At(i).Description())
+					assert.Equal(t, "bit", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device.interface")
+					assert.True(t, ok)
+					assert.Equal(t, "device.interface-val", attrVal.Str())
+				case "bigip.net.interface.bits.sent":
+					assert.False(t, validatedMetrics["bigip.net.interface.bits.sent"], "Found a duplicate in the metrics slice: bigip.net.interface.bits.sent")
+					validatedMetrics["bigip.net.interface.bits.sent"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of bits sent by the network interface.", ms.At(i).Description())
+					assert.Equal(t, "bit", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device.interface")
+					assert.True(t, ok)
+					assert.Equal(t, "device.interface-val", attrVal.Str())
+				case "bigip.net.interface.drops.received":
+					assert.False(t, validatedMetrics["bigip.net.interface.drops.received"], "Found a duplicate in the metrics slice: bigip.net.interface.drops.received")
+					validatedMetrics["bigip.net.interface.drops.received"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of received packets dropped by the network interface.", ms.At(i).Description())
+					assert.Equal(t, "{packets}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device.interface")
+					assert.True(t, ok)
+					assert.Equal(t, "device.interface-val", attrVal.Str())
+				case "bigip.net.interface.drops.sent":
+					assert.False(t, validatedMetrics["bigip.net.interface.drops.sent"], "Found a duplicate in the metrics slice: bigip.net.interface.drops.sent")
+					validatedMetrics["bigip.net.interface.drops.sent"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of outgoing packets dropped by the network interface.", ms.At(i).Description())
+					assert.Equal(t, "{packets}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("device.interface")
+					assert.True(t, ok)
+					assert.Equal(t, "device.interface-val", attrVal.Str())
 				case "bigip.node.availability":
 					assert.False(t, validatedMetrics["bigip.node.availability"], "Found a duplicate in the metrics slice: bigip.node.availability")
 					validatedMetrics["bigip.node.availability"] = true
@@ -482,6 +714,24 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("status")
 					assert.True(t, ok)
 					assert.Equal(t, "disabled", attrVal.Str())
+				case "bigip.pool_member.monitor.status":
+					assert.False(t, validatedMetrics["bigip.pool_member.monitor.status"], "Found a duplicate in the metrics slice: bigip.pool_member.monitor.status")
+					validatedMetrics["bigip.pool_member.monitor.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Monitor status of the pool member. A single data point is reported with the current availability status and the reason given by the health monitor. The reason is free-form text, every distinct reason creates a new time series.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("status")
+					assert.True(t, ok)
+					assert.Equal(t, "offline", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("reason")
+					assert.True(t, ok)
+					assert.Equal(t, "status.reason-val", attrVal.Str())
 				case "bigip.pool_member.packet.count":
 					assert.False(t, validatedMetrics["bigip.pool_member.packet.count"], "Found a duplicate in the metrics slice: bigip.pool_member.packet.count")
 					validatedMetrics["bigip.pool_member.packet.count"] = true
@@ -527,6 +777,167 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "bigip.snatpool.connections":
+					assert.False(t, validatedMetrics["bigip.snatpool.connections"], "Found a duplicate in the metrics slice: bigip.snatpool.connections")
+					validatedMetrics["bigip.snatpool.connections"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Current number of server side connections using the translation addresses of the SNAT pool.", ms.At(i).Description())
+					assert.Equal(t, "{connections}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("snatpool.name")
+					assert.True(t, ok)
+					assert.Equal(t, "snatpool.name-val", attrVal.Str())
+				case "bigip.snatpool.translation_address.count":
+					assert.False(t, validatedMetrics["bigip.snatpool.translation_address.count"], "Found a duplicate in the metrics slice: bigip.snatpool.translation_address.count")
+					validatedMetrics["bigip.snatpool.translation_address.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of translation addresses of the SNAT pool.", ms.At(i).Description())
+					assert.Equal(t, "{addresses}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("snatpool.name")
+					assert.True(t, ok)
+					assert.Equal(t, "snatpool.name-val", attrVal.Str())
+				case "bigip.ssl.profile.cipher.count":
+					assert.False(t, validatedMetrics["bigip.ssl.profile.cipher.count"], "Found a duplicate in the metrics slice: bigip.ssl.profile.cipher.count")
+					validatedMetrics["bigip.ssl.profile.cipher.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of connections negotiated with each bulk cipher family by the client SSL profile.", ms.At(i).Description())
+					assert.Equal(t, "{connections}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("cipher")
+					assert.True(t, ok)
+					assert.Equal(t, "ssl.cipher-val", attrVal.Str())
+				case "bigip.ssl.profile.connections.current":
+					assert.False(t, validatedMetrics["bigip.ssl.profile.connections.current"], "Found a duplicate in the metrics slice: bigip.ssl.profile.connections.current")
+					validatedMetrics["bigip.ssl.profile.connections.current"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Current number of connections handled by the client SSL profile.", ms.At(i).Description())
+					assert.Equal(t, "{connections}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "bigip.ssl.profile.handshakes":
+					assert.False(t, validatedMetrics["bigip.ssl.profile.handshakes"], "Found a duplicate in the metrics slice: bigip.ssl.profile.handshakes")
+					validatedMetrics["bigip.ssl.profile.handshakes"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of SSL/TLS handshakes handled by the client SSL profile.", ms.At(i).Description())
+					assert.Equal(t, "{handshakes}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("result")
+					assert.True(t, ok)
+					assert.Equal(t, "success", attrVal.Str())
+				case "bigip.ssl.profile.key_exchange.count":
+					assert.False(t, validatedMetrics["bigip.ssl.profile.key_exchange.count"], "Found a duplicate in the metrics slice: bigip.ssl.profile.key_exchange.count")
+					validatedMetrics["bigip.ssl.profile.key_exchange.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of connections negotiated with each key exchange algorithm by the client SSL profile.", ms.At(i).Description())
+					assert.Equal(t, "{connections}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("key_exchange")
+					assert.True(t, ok)
+					assert.Equal(t, "ssl.key_exchange-val", attrVal.Str())
+				case "bigip.ssl.profile.protocol.count":
+					assert.False(t, validatedMetrics["bigip.ssl.profile.protocol.count"], "Found a duplicate in the metrics slice: bigip.ssl.profile.protocol.count")
+					validatedMetrics["bigip.ssl.profile.protocol.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of connections negotiated with each protocol version by the client SSL profile.", ms.At(i).Description())
+					assert.Equal(t, "{connections}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("protocol")
+					assert.True(t, ok)
+					assert.Equal(t, "ssl.protocol-val", attrVal.Str())
+				case "bigip.system.memory.total":
+					assert.False(t, validatedMetrics["bigip.system.memory.total"], "Found a duplicate in the metrics slice: bigip.system.memory.total")
+					validatedMetrics["bigip.system.memory.total"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Total amount of memory available on the device.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("memory.type")
+					assert.True(t, ok)
+					assert.Equal(t, "tmm", attrVal.Str())
+				case "bigip.system.memory.used":
+					assert.False(t, validatedMetrics["bigip.system.memory.used"], "Found a duplicate in the metrics slice: bigip.system.memory.used")
+					validatedMetrics["bigip.system.memory.used"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Amount of memory in use on the device.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("memory.type")
+					assert.True(t, ok)
+					assert.Equal(t, "tmm", attrVal.Str())
+				case "bigip.system.tmm.cpu.utilization":
+					assert.False(t, validatedMetrics["bigip.system.tmm.cpu.utilization"], "Found a duplicate in the metrics slice: bigip.system.tmm.cpu.utilization")
+					validatedMetrics["bigip.system.tmm.cpu.utilization"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "CPU utilization of the traffic management microkernel, averaged over the last five seconds.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("tmm")
+					assert.True(t, ok)
+					assert.Equal(t, "tmm-val", attrVal.Str())
 				case "bigip.virtual_server.availability":
 					assert.False(t, validatedMetrics["bigip.virtual_server.availability"], "Found a duplicate in the metrics slice: bigip.virtual_server.availability")
 					validatedMetrics["bigip.virtual_server.availability"] = true
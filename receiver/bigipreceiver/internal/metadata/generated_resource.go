@@ -21,6 +21,20 @@ func NewResourceBuilder(rac ResourceAttributesConfig) *ResourceBuilder {
 	}
 }
 
+// SetBigipDeviceEndpoint sets provided value as "bigip.device.endpoint" attribute.
+func (rb *ResourceBuilder) SetBigipDeviceEndpoint(val string) {
+	if rb.config.BigipDeviceEndpoint.Enabled {
+		rb.res.Attributes().PutStr("bigip.device.endpoint", val)
+	}
+}
+
+// SetBigipHTTPProfileName sets provided value as "bigip.http.profile.name" attribute.
+func (rb *ResourceBuilder) SetBigipHTTPProfileName(val string) {
+	if rb.config.BigipHTTPProfileName.Enabled {
+		rb.res.Attributes().PutStr("bigip.http.profile.name", val)
+	}
+}
+
 // SetBigipNodeIPAddress sets provided value as "bigip.node.ip_address" attribute.
 func (rb *ResourceBuilder) SetBigipNodeIPAddress(val string) {
 	if rb.config.BigipNodeIPAddress.Enabled {
@@ -56,6 +70,13 @@ func (rb *ResourceBuilder) SetBigipPoolMemberName(val string) {
 	}
 }
 
+// SetBigipSslProfileName sets provided value as "bigip.ssl.profile.name" attribute.
+func (rb *ResourceBuilder) SetBigipSslProfileName(val string) {
+	if rb.config.BigipSslProfileName.Enabled {
+		rb.res.Attributes().PutStr("bigip.ssl.profile.name", val)
+	}
+}
+
 // SetBigipVirtualServerDestination sets provided value as "bigip.virtual_server.destination" attribute.
 func (rb *ResourceBuilder) SetBigipVirtualServerDestination(val string) {
 	if rb.config.BigipVirtualServerDestination.Enabled {
@@ -13,11 +13,14 @@ func TestResourceBuilder(t *testing.T) {
 		t.Run(tt, func(t *testing.T) {
 			cfg := loadResourceAttributesConfig(t, tt)
 			rb := NewResourceBuilder(cfg)
+			rb.SetBigipDeviceEndpoint("bigip.device.endpoint-val")
+			rb.SetBigipHTTPProfileName("bigip.http.profile.name-val")
 			rb.SetBigipNodeIPAddress("bigip.node.ip_address-val")
 			rb.SetBigipNodeName("bigip.node.name-val")
 			rb.SetBigipPoolName("bigip.pool.name-val")
 			rb.SetBigipPoolMemberIPAddress("bigip.pool_member.ip_address-val")
 			rb.SetBigipPoolMemberName("bigip.pool_member.name-val")
+			rb.SetBigipSslProfileName("bigip.ssl.profile.name-val")
 			rb.SetBigipVirtualServerDestination("bigip.virtual_server.destination-val")
 			rb.SetBigipVirtualServerName("bigip.virtual_server.name-val")
 
@@ -26,9 +29,9 @@ func TestResourceBuilder(t *testing.T) {
 
 			switch tt {
 			case "default":
-				assert.Equal(t, 7, res.Attributes().Len())
+				assert.Equal(t, 10, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 7, res.Attributes().Len())
+				assert.Equal(t, 10, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -36,7 +39,17 @@ func TestResourceBuilder(t *testing.T) {
 				assert.Failf(t, "unexpected test case: %s", tt)
 			}
 
-			val, ok := res.Attributes().Get("bigip.node.ip_address")
+			val, ok := res.Attributes().Get("bigip.device.endpoint")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "bigip.device.endpoint-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("bigip.http.profile.name")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "bigip.http.profile.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("bigip.node.ip_address")
 			assert.True(t, ok)
 			if ok {
 				assert.Equal(t, "bigip.node.ip_address-val", val.Str())
@@ -61,6 +74,11 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "bigip.pool_member.name-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("bigip.ssl.profile.name")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "bigip.ssl.profile.name-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("bigip.virtual_server.destination")
 			assert.True(t, ok)
 			if ok {
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -15,6 +16,135 @@ type MockClient struct {
 	mock.Mock
 }
 
+// DeleteToken provides a mock function with given fields: ctx
+func (_m *MockClient) DeleteToken(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetClientSSLProfiles provides a mock function with given fields: ctx
+func (_m *MockClient) GetClientSSLProfiles(ctx context.Context) (*models.ClientSSLProfiles, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *models.ClientSSLProfiles
+	if rf, ok := ret.Get(0).(func(context.Context) *models.ClientSSLProfiles); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ClientSSLProfiles)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCustomStats provides a mock function with given fields: ctx, path
+func (_m *MockClient) GetCustomStats(ctx context.Context, path string) (*models.CustomStats, error) {
+	ret := _m.Called(ctx, path)
+
+	var r0 *models.CustomStats
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.CustomStats); ok {
+		r0 = rf(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CustomStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFailoverStatus provides a mock function with given fields: ctx
+func (_m *MockClient) GetFailoverStatus(ctx context.Context) (*models.FailoverStatus, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *models.FailoverStatus
+	if rf, ok := ret.Get(0).(func(context.Context) *models.FailoverStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.FailoverStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHTTPProfiles provides a mock function with given fields: ctx
+func (_m *MockClient) GetHTTPProfiles(ctx context.Context) (*models.HTTPProfiles, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *models.HTTPProfiles
+	if rf, ok := ret.Get(0).(func(context.Context) *models.HTTPProfiles); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.HTTPProfiles)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInterfaces provides a mock function with given fields: ctx
+func (_m *MockClient) GetInterfaces(ctx context.Context) (*models.InterfaceStats, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *models.InterfaceStats
+	if rf, ok := ret.Get(0).(func(context.Context) *models.InterfaceStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InterfaceStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNewToken provides a mock function with given fields: ctx
 func (_m *MockClient) GetNewToken(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -98,6 +228,98 @@ func (_m *MockClient) GetPools(ctx context.Context) (*models.Pools, error) {
 	return r0, r1
 }
 
+// GetSNATPools provides a mock function with given fields: ctx
+func (_m *MockClient) GetSNATPools(ctx context.Context) (*models.SNATPools, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *models.SNATPools
+	if rf, ok := ret.Get(0).(func(context.Context) *models.SNATPools); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SNATPools)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSystemStats provides a mock function with given fields: ctx
+func (_m *MockClient) GetSystemStats(ctx context.Context) (*models.SystemStats, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *models.SystemStats
+	if rf, ok := ret.Get(0).(func(context.Context) *models.SystemStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SystemStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTMMStats provides a mock function with given fields: ctx
+func (_m *MockClient) GetTMMStats(ctx context.Context) (*models.TMMStats, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *models.TMMStats
+	if rf, ok := ret.Get(0).(func(context.Context) *models.TMMStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TMMStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrafficStats provides a mock function with given fields: ctx
+func (_m *MockClient) GetTrafficStats(ctx context.Context) (*models.TrafficStats, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *models.TrafficStats
+	if rf, ok := ret.Get(0).(func(context.Context) *models.TrafficStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TrafficStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVirtualServers provides a mock function with given fields: ctx
 func (_m *MockClient) GetVirtualServers(ctx context.Context) (*models.VirtualServers, error) {
 	ret := _m.Called(ctx)
@@ -134,3 +356,17 @@ func (_m *MockClient) HasToken() bool {
 
 	return r0
 }
+
+// HasValidToken provides a mock function with given fields: refreshBuffer
+func (_m *MockClient) HasValidToken(refreshBuffer time.Duration) bool {
+	ret := _m.Called(refreshBuffer)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(time.Duration) bool); ok {
+		r0 = rf(refreshBuffer)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
@@ -0,0 +1,17 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// ClientSSLProfiles represents the top level json returned by the profile/client-ssl/stats endpoint
+type ClientSSLProfiles struct {
+	Entries map[string]ClientSSLProfileStats `json:"entries"`
+}
+
+// ClientSSLProfileStats represents the statistics returned for a single client SSL profile.
+// The protocol and cipher counters reported vary between Big-IP versions, so they are kept in a map.
+type ClientSSLProfileStats struct {
+	NestedStats struct {
+		Entries map[string]CustomStatsValue `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
@@ -0,0 +1,22 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// CustomStats represents the top level json returned by an arbitrary statistics endpoint
+type CustomStats struct {
+	Entries map[string]CustomStatsEntry `json:"entries"`
+}
+
+// CustomStatsEntry represents the statistics returned for a single object of an arbitrary statistics endpoint
+type CustomStatsEntry struct {
+	NestedStats struct {
+		Entries map[string]CustomStatsValue `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
+
+// CustomStatsValue represents a single statistic, which holds either a numeric value or a description
+type CustomStatsValue struct {
+	Value       *int64 `json:"value,omitempty"`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// CMStatus represents the top level json returned by the cm/failover-status and cm/sync-status endpoints
+type CMStatus struct {
+	Entries map[string]CMStatusEntry `json:"entries"`
+}
+
+// CMStatusEntry represents the status reported by the device
+type CMStatusEntry struct {
+	NestedStats struct {
+		Entries struct {
+			Status struct {
+				Description string `json:"description"`
+			} `json:"status,omitempty"`
+		} `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
+
+// Devices represents the top level json returned by the cm/device endpoint
+type Devices struct {
+	Items []DeviceProperties `json:"items"`
+}
+
+// DeviceProperties represents the properties of a device of the trust domain
+type DeviceProperties struct {
+	Name       string `json:"name"`
+	SelfDevice string `json:"selfDevice"`
+}
+
+// FailoverStatus represents the combined failover and config sync status of the device
+type FailoverStatus struct {
+	Device         string
+	FailoverStatus string
+	SyncStatus     string
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// HTTPProfiles represents the top level json returned by the profile/http/stats endpoint
+type HTTPProfiles struct {
+	Entries map[string]HTTPProfileStats `json:"entries"`
+}
+
+// HTTPProfileStats represents the statistics returned for a single HTTP profile
+type HTTPProfileStats struct {
+	NestedStats struct {
+		Entries struct {
+			Name struct {
+				Description string `json:"description"`
+			} `json:"tmName,omitempty"`
+			Resp2xxCnt struct {
+				Value int64 `json:"value"`
+			} `json:"resp_2xxCnt,omitempty"`
+			Resp3xxCnt struct {
+				Value int64 `json:"value"`
+			} `json:"resp_3xxCnt,omitempty"`
+			Resp4xxCnt struct {
+				Value int64 `json:"value"`
+			} `json:"resp_4xxCnt,omitempty"`
+			Resp5xxCnt struct {
+				Value int64 `json:"value"`
+			} `json:"resp_5xxCnt,omitempty"`
+		} `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
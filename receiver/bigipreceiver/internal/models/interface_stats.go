@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// InterfaceStats represents the top level json returned by the net/interface/stats endpoint
+type InterfaceStats struct {
+	Entries map[string]InterfaceStatsEntry `json:"entries"`
+}
+
+// InterfaceStatsEntry represents the statistics of a single network interface
+type InterfaceStatsEntry struct {
+	NestedStats struct {
+		Entries struct {
+			Name struct {
+				Description string `json:"description"`
+			} `json:"tmName,omitempty"`
+			BitsIn struct {
+				Value int64 `json:"value"`
+			} `json:"counters.bitsIn,omitempty"`
+			BitsOut struct {
+				Value int64 `json:"value"`
+			} `json:"counters.bitsOut,omitempty"`
+			DropsIn struct {
+				Value int64 `json:"value"`
+			} `json:"counters.dropsIn,omitempty"`
+			DropsOut struct {
+				Value int64 `json:"value"`
+			} `json:"counters.dropsOut,omitempty"`
+		} `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
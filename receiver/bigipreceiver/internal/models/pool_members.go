@@ -45,6 +45,9 @@ type PoolMemberStats struct {
 			EnabledState struct {
 				Description string `json:"description,omitempty"`
 			} `json:"status.enabledState,omitempty"`
+			StatusReason struct {
+				Description string `json:"description,omitempty"`
+			} `json:"status.statusReason,omitempty"`
 			TotalRequests struct {
 				Value int64 `json:"value"`
 			} `json:"totRequests,omitempty"`
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// SNATPoolsDetails represents the top level json returned by the /snatpool endpoint
+type SNATPoolsDetails struct {
+	Items []SNATPoolProperties `json:"items"`
+}
+
+// SNATPoolProperties represents the properties returned for a single SNAT pool
+type SNATPoolProperties struct {
+	FullPath string `json:"fullPath"`
+	// Members are the full paths of the translation addresses of the SNAT pool
+	Members []string `json:"members"`
+}
+
+// SNATTranslations represents the top level json returned by the snat-translation/stats endpoint
+type SNATTranslations struct {
+	Entries map[string]SNATTranslationStats `json:"entries"`
+}
+
+// SNATTranslationStats represents the statistics returned for a single SNAT translation address
+type SNATTranslationStats struct {
+	NestedStats struct {
+		Entries struct {
+			Name struct {
+				Description string `json:"description,omitempty"`
+			} `json:"tmName,omitempty"`
+			ServersideCurConns struct {
+				Value int64 `json:"value"`
+			} `json:"serverside.curConns,omitempty"`
+		} `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
+
+// SNATPools holds the SNAT pools of a Big-IP environment, combined with the statistics of their translation addresses
+type SNATPools struct {
+	Pools []SNATPool
+}
+
+// SNATPool holds the utilization of a single SNAT pool
+type SNATPool struct {
+	Name string
+	// TranslationAddresses is the number of translation addresses of the pool
+	TranslationAddresses int64
+	// Connections is the number of current server side connections using the translation addresses of the pool
+	Connections int64
+}
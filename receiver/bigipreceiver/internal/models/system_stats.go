@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// SystemStats represents the top level json returned by the sys/memory/stats endpoint
+type SystemStats struct {
+	Entries map[string]SystemStatsGroup `json:"entries"`
+}
+
+// SystemStatsGroup represents a group of memory statistics, such as the one of all hosts
+type SystemStatsGroup struct {
+	NestedStats struct {
+		Entries map[string]SystemStatsEntry `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
+
+// SystemStatsEntry represents the memory statistics of a single host
+type SystemStatsEntry struct {
+	NestedStats struct {
+		Entries struct {
+			HostID struct {
+				Description string `json:"description"`
+			} `json:"hostId,omitempty"`
+			TmmMemoryTotal struct {
+				Value int64 `json:"value"`
+			} `json:"tmmMemoryTotal,omitempty"`
+			TmmMemoryUsed struct {
+				Value int64 `json:"value"`
+			} `json:"tmmMemoryUsed,omitempty"`
+			OtherMemoryTotal struct {
+				Value int64 `json:"value"`
+			} `json:"otherMemoryTotal,omitempty"`
+			OtherMemoryUsed struct {
+				Value int64 `json:"value"`
+			} `json:"otherMemoryUsed,omitempty"`
+		} `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
@@ -0,0 +1,23 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// TMMStats represents the top level json returned by the sys/tmm-info/stats endpoint
+type TMMStats struct {
+	Entries map[string]TMMStatsEntry `json:"entries"`
+}
+
+// TMMStatsEntry represents the statistics of a single traffic management microkernel
+type TMMStatsEntry struct {
+	NestedStats struct {
+		Entries struct {
+			TMMID struct {
+				Description string `json:"description"`
+			} `json:"tmmId,omitempty"`
+			FiveSecAvgUsageRatio struct {
+				Value int64 `json:"value"`
+			} `json:"fiveSecAvgUsageRatio,omitempty"`
+		} `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
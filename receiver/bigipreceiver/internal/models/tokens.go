@@ -11,4 +11,6 @@ type TokenDetails struct {
 // Token represents where the actual token data is stored
 type Token struct {
 	Token string `json:"token,omitempty"`
+	// ExpirationMicros is the expiration time of the token in microseconds since the Unix epoch
+	ExpirationMicros int64 `json:"expirationMicros,omitempty"`
 }
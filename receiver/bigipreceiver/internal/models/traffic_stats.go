@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// TrafficStats represents the top level json returned by the sys/traffic/stats endpoint
+type TrafficStats struct {
+	Entries map[string]TrafficStatsEntry `json:"entries"`
+}
+
+// TrafficStatsEntry represents the device wide traffic statistics
+type TrafficStatsEntry struct {
+	NestedStats struct {
+		Entries struct {
+			ClientsideBitsIn struct {
+				Value int64 `json:"value"`
+			} `json:"clientSideTraffic.bitsIn,omitempty"`
+			ClientsideBitsOut struct {
+				Value int64 `json:"value"`
+			} `json:"clientSideTraffic.bitsOut,omitempty"`
+			ClientsideCurConns struct {
+				Value int64 `json:"value"`
+			} `json:"clientSideTraffic.curConns,omitempty"`
+			ClientsidePktsIn struct {
+				Value int64 `json:"value"`
+			} `json:"clientSideTraffic.pktsIn,omitempty"`
+			ClientsidePktsOut struct {
+				Value int64 `json:"value"`
+			} `json:"clientSideTraffic.pktsOut,omitempty"`
+		} `json:"entries,omitempty"`
+	} `json:"nestedStats,omitempty"`
+}
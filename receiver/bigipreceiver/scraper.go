@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -15,7 +16,9 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
@@ -27,113 +30,524 @@ var (
 	errScrapedNoMetrics = errors.New("failed to scrape any metrics")
 )
 
+// maxStatusReasonLength bounds the length of the reported monitor status reason
+const maxStatusReasonLength = 128
+
+// statusReasonTimestamp matches the time of the last monitor check appended to status reasons,
+// e.g. "@2022/04/29 08:13:38."
+var statusReasonTimestamp = regexp.MustCompile(`\s*@\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2}\.?`)
+
+// sslProtocols maps the client SSL profile protocol counters to the reported protocol version
+var sslProtocols = map[string]string{
+	"common.protocolUses.sslv2":   "SSLv2",
+	"common.protocolUses.sslv3":   "SSLv3",
+	"common.protocolUses.tlsv1":   "TLSv1.0",
+	"common.protocolUses.tlsv1_1": "TLSv1.1",
+	"common.protocolUses.tlsv1_2": "TLSv1.2",
+	"common.protocolUses.tlsv1_3": "TLSv1.3",
+	"common.protocolUses.dtlsv1":  "DTLSv1.0",
+}
+
+// sslCiphers maps the client SSL profile bulk cipher counters to the reported cipher family
+var sslCiphers = map[string]string{
+	"common.cipherUses.aesBulk":              "AES",
+	"common.cipherUses.aesGcmBulk":           "AES-GCM",
+	"common.cipherUses.camelliaBulk":         "CAMELLIA",
+	"common.cipherUses.chacha20Poly1305Bulk": "CHACHA20-POLY1305",
+	"common.cipherUses.desBulk":              "DES",
+	"common.cipherUses.ideaBulk":             "IDEA",
+	"common.cipherUses.nullBulk":             "NULL",
+	"common.cipherUses.rc2Bulk":              "RC2",
+	"common.cipherUses.rc4Bulk":              "RC4",
+}
+
+// sslKeyExchanges maps the client SSL profile key exchange counters to the reported key exchange algorithm
+var sslKeyExchanges = map[string]string{
+	"common.cipherUses.adhKeyxchg":        "ADH",
+	"common.cipherUses.dhRsaKeyxchg":      "DHE-RSA",
+	"common.cipherUses.ecdhEcdsaKeyxchg":  "ECDH-ECDSA",
+	"common.cipherUses.ecdhRsaKeyxchg":    "ECDH-RSA",
+	"common.cipherUses.ecdheEcdsaKeyxchg": "ECDHE-ECDSA",
+	"common.cipherUses.ecdheRsaKeyxchg":   "ECDHE-RSA",
+	"common.cipherUses.rsaKeyxchg":        "RSA",
+}
+
+// bigipDevice is a Big-IP device listed in the endpoints of the config
+type bigipDevice struct {
+	endpoint string
+	client   client
+}
+
 // bigipScraper handles scraping of Big-IP metrics
 type bigipScraper struct {
-	client   client
-	logger   *zap.Logger
-	cfg      *Config
-	settings component.TelemetrySettings
-	mb       *metadata.MetricsBuilder
+	// client is the client of the device at the top level endpoint, it is only used when no devices are listed
+	client    client
+	devices   []bigipDevice
+	logger    *zap.Logger
+	cfg       *Config
+	settings  component.TelemetrySettings
+	mb        *metadata.MetricsBuilder
+	version   string
+	startTime pcommon.Timestamp
+	// partitions, includeFilter and excludeFilter are nil when not configured
+	partitions    map[string]struct{}
+	includeFilter *regexp.Regexp
+	excludeFilter *regexp.Regexp
 }
 
 // newScraper creates an initialized bigipScraper
 func newScraper(logger *zap.Logger, cfg *Config, settings receiver.Settings) *bigipScraper {
-	return &bigipScraper{
-		logger:   logger,
-		cfg:      cfg,
-		settings: settings.TelemetrySettings,
-		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+	s := &bigipScraper{
+		logger:    logger,
+		cfg:       cfg,
+		settings:  settings.TelemetrySettings,
+		mb:        metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		version:   settings.BuildInfo.Version,
+		startTime: pcommon.NewTimestampFromTime(time.Now()),
+	}
+	if len(cfg.Partitions) > 0 {
+		s.partitions = make(map[string]struct{}, len(cfg.Partitions))
+		for _, partition := range cfg.Partitions {
+			s.partitions[partition] = struct{}{}
+		}
+	}
+	// the filters have already been checked by Config.Validate
+	if cfg.IncludeNameFilter != "" {
+		s.includeFilter = regexp.MustCompile(cfg.IncludeNameFilter)
+	}
+	if cfg.ExcludeNameFilter != "" {
+		s.excludeFilter = regexp.MustCompile(cfg.ExcludeNameFilter)
 	}
+	return s
 }
 
-// start initializes a new big-ip client for the scraper
+// start initializes a new big-ip client for the scraper, or one for each listed device
 func (s *bigipScraper) start(ctx context.Context, host component.Host) (err error) {
-	s.client, err = newClient(ctx, s.cfg, host, s.settings, s.logger)
-	return
+	if len(s.cfg.Endpoints) == 0 {
+		s.client, err = newClient(ctx, s.cfg, host, s.settings, s.logger)
+		return
+	}
+
+	devices := make([]bigipDevice, 0, len(s.cfg.Endpoints))
+	for i := range s.cfg.Endpoints {
+		deviceCfg := s.cfg.deviceConfig(&s.cfg.Endpoints[i])
+		deviceClient, err := newClient(ctx, deviceCfg, host, s.settings, s.logger.With(zap.String("endpoint", deviceCfg.Endpoint)))
+		if err != nil {
+			return err
+		}
+		devices = append(devices, bigipDevice{endpoint: deviceCfg.Endpoint, client: deviceClient})
+	}
+	s.devices = devices
+	return nil
 }
 
-// scrape collects and creates OTEL metrics from a Big-IP environment
+// shutdown deletes the auth tokens of the clients from the Big-IP devices, unless configured not to
+func (s *bigipScraper) shutdown(ctx context.Context) error {
+	if !s.cfg.LogoutOnShutdown {
+		return nil
+	}
+
+	if len(s.devices) == 0 {
+		s.deleteToken(ctx, s.client)
+	}
+	for _, device := range s.devices {
+		s.deleteToken(ctx, device.client)
+	}
+	return nil
+}
+
+// deleteToken deletes the auth token of the client, if it has one
+func (s *bigipScraper) deleteToken(ctx context.Context, c client) {
+	if c == nil || !c.HasToken() {
+		return
+	}
+
+	// failing to clean up the token must not prevent the collector from shutting down
+	if err := c.DeleteToken(ctx); err != nil {
+		s.logger.Warn("Failed to delete auth token on shutdown", zap.Error(err))
+	}
+}
+
+// scrape collects and creates OTEL metrics from the configured Big-IP devices
 func (s *bigipScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if len(s.devices) == 0 {
+		return s.scrapeDevice(ctx, s.client)
+	}
+
+	// a device failing to be scraped does not abort the others, its error is reported as a partial error
+	metrics := pmetric.NewMetrics()
+	collectedMetrics := false
+	var deviceErrs []error
+	var scrapeErrors scrapererror.ScrapeErrors
+	for _, device := range s.devices {
+		deviceMetrics, err := s.scrapeDevice(ctx, device.client)
+		if err != nil {
+			s.logger.Warn("Failed to scrape device", zap.String("endpoint", device.endpoint), zap.Error(err))
+			deviceErr := fmt.Errorf("device %s: %w", device.endpoint, err)
+			deviceErrs = append(deviceErrs, deviceErr)
+
+			var partialErr scrapererror.PartialScrapeError
+			if !errors.As(err, &partialErr) {
+				scrapeErrors.AddPartial(1, deviceErr)
+				continue
+			}
+			scrapeErrors.AddPartial(partialErr.Failed, deviceErr)
+		}
+
+		collectedMetrics = true
+		resourceMetrics := deviceMetrics.ResourceMetrics()
+		if s.cfg.ResourceAttributes.BigipDeviceEndpoint.Enabled {
+			for i := 0; i < resourceMetrics.Len(); i++ {
+				resourceMetrics.At(i).Resource().Attributes().PutStr("bigip.device.endpoint", device.endpoint)
+			}
+		}
+		resourceMetrics.MoveAndAppendTo(metrics.ResourceMetrics())
+	}
+
+	if !collectedMetrics {
+		return pmetric.NewMetrics(), multierr.Combine(deviceErrs...)
+	}
+
+	return metrics, scrapeErrors.Combine()
+}
+
+// scrapeDevice collects and creates OTEL metrics from the Big-IP device of the passed in client
+func (s *bigipScraper) scrapeDevice(ctx context.Context, c client) (pmetric.Metrics, error) {
 	now := pcommon.NewTimestampFromTime(time.Now())
 
 	// validate we don't attempt to scrape without initializing the client
-	if s.client == nil {
+	if c == nil {
 		return pmetric.NewMetrics(), errClientNotInit
 	}
 
 	collectedMetrics := false
 
-	// initialize auth token
-	err := s.client.GetNewToken(ctx)
-	if err != nil {
-		return pmetric.NewMetrics(), err
+	// initialize auth token, the current one is reused until it is about to expire
+	if !c.HasValidToken(s.cfg.TokenRefreshBuffer) {
+		if err := s.getNewToken(ctx, c); err != nil {
+			return pmetric.NewMetrics(), err
+		}
 	}
 
+	// fetch the independent object lists concurrently, pool members depend on the pools so they are fetched right after them
+	var (
+		virtualServers              *models.VirtualServers
+		pools                       *models.Pools
+		poolMembers                 *models.PoolMembers
+		nodes                       *models.Nodes
+		virtualServersErr, poolsErr error
+		poolMembersErr, nodesErr    error
+	)
+	var group errgroup.Group
+	group.Go(func() error {
+		virtualServers, virtualServersErr = c.GetVirtualServers(ctx)
+		return nil
+	})
+	group.Go(func() error {
+		pools, poolsErr = c.GetPools(ctx)
+		if poolsErr == nil {
+			poolMembers, poolMembersErr = c.GetPoolMembers(ctx, pools)
+		}
+		return nil
+	})
+	group.Go(func() error {
+		nodes, nodesErr = c.GetNodes(ctx)
+		return nil
+	})
+	_ = group.Wait()
+
+	// the results are processed in a fixed order, which keeps the aggregated scrape error stable
 	var scrapeErrors scrapererror.ScrapeErrors
 	// scrape metrics for virtual servers
-	virtualServers, err := s.client.GetVirtualServers(ctx)
-	if err != nil {
-		scrapeErrors.AddPartial(1, err)
-		s.logger.Warn("Failed to scrape virtual server metrics", zap.Error(err))
+	if virtualServersErr != nil {
+		scrapeErrors.AddPartial(1, virtualServersErr)
+		s.logger.Warn("Failed to scrape virtual server metrics", zap.Error(virtualServersErr))
 	} else {
 		collectedMetrics = true
 		for key := range virtualServers.Entries {
 			virtualServerStats := virtualServers.Entries[key]
+			if s.skipDisabled(virtualServerStats.NestedStats.Entries.EnabledState.Description) || s.skipName(virtualServerStats.NestedStats.Entries.Name.Description) {
+				continue
+			}
 			s.collectVirtualServers(&virtualServerStats, now)
 		}
 	}
 
 	// scrape metrics for pools
-	pools, err := s.client.GetPools(ctx)
-	if err != nil {
-		scrapeErrors.AddPartial(1, err)
-		s.logger.Warn("Failed to scrape pool metrics", zap.Error(err))
+	if poolsErr != nil {
+		scrapeErrors.AddPartial(1, poolsErr)
+		s.logger.Warn("Failed to scrape pool metrics", zap.Error(poolsErr))
 	} else {
 		collectedMetrics = true
 		for key := range pools.Entries {
 			poolStats := pools.Entries[key]
+			if s.skipDisabled(poolStats.NestedStats.Entries.EnabledState.Description) || s.skipName(poolStats.NestedStats.Entries.Name.Description) {
+				continue
+			}
 			s.collectPools(&poolStats, now)
 		}
-	}
 
-	if pools != nil {
 		// scrape metrics for pool members
-		poolMembers, err2 := s.client.GetPoolMembers(ctx, pools)
-		if errors.Is(err2, errCollectedNoPoolMembers) {
-			scrapeErrors.AddPartial(1, err2)
-			s.logger.Warn("Failed to scrape pool member metrics", zap.Error(err2))
+		if errors.Is(poolMembersErr, errCollectedNoPoolMembers) {
+			scrapeErrors.AddPartial(1, poolMembersErr)
+			s.logger.Warn("Failed to scrape pool member metrics", zap.Error(poolMembersErr))
 		} else {
-			if err2 != nil {
-				scrapeErrors.AddPartial(1, err2)
-				s.logger.Warn("Failed to scrape some pool member metrics", zap.Error(err2))
+			if poolMembersErr != nil {
+				scrapeErrors.AddPartial(1, poolMembersErr)
+				s.logger.Warn("Failed to scrape some pool member metrics", zap.Error(poolMembersErr))
 			}
 
 			collectedMetrics = true
 			for key := range poolMembers.Entries {
 				poolMemberStats := poolMembers.Entries[key]
+				if s.skipDisabled(poolMemberStats.NestedStats.Entries.EnabledState.Description) || s.skipName(poolMemberName(&poolMemberStats)) {
+					continue
+				}
 				s.collectPoolMembers(&poolMemberStats, now)
 			}
 		}
 	}
 
 	// scrape metrics for nodes
-	nodes, err := s.client.GetNodes(ctx)
-	if err != nil {
-		scrapeErrors.AddPartial(1, err)
-		s.logger.Warn("Failed to scrape node metrics", zap.Error(err))
+	if nodesErr != nil {
+		scrapeErrors.AddPartial(1, nodesErr)
+		s.logger.Warn("Failed to scrape node metrics", zap.Error(nodesErr))
 	} else {
 		collectedMetrics = true
 		for key := range nodes.Entries {
 			nodeStats := nodes.Entries[key]
+			if s.skipDisabled(nodeStats.NestedStats.Entries.EnabledState.Description) || s.skipName(nodeStats.NestedStats.Entries.Name.Description) {
+				continue
+			}
 			s.collectNodes(&nodeStats, now)
 		}
 	}
 
+	// scrape metrics for client SSL profiles, only when at least one of their metrics is enabled
+	if s.sslProfileMetricsEnabled() {
+		profiles, err := c.GetClientSSLProfiles(ctx)
+		if err != nil {
+			scrapeErrors.AddPartial(1, err)
+			s.logger.Warn("Failed to scrape client SSL profile metrics", zap.Error(err))
+		} else {
+			collectedMetrics = true
+			for key := range profiles.Entries {
+				profileStats := profiles.Entries[key]
+				s.collectClientSSLProfiles(&profileStats, now)
+			}
+		}
+	}
+
+	// scrape SNAT pool metrics, only when at least one of them is enabled
+	if s.snatPoolMetricsEnabled() {
+		snatPools, err := c.GetSNATPools(ctx)
+		if err != nil {
+			scrapeErrors.AddPartial(1, err)
+			s.logger.Warn("Failed to scrape SNAT pool metrics", zap.Error(err))
+		} else {
+			collectedMetrics = true
+			s.collectSNATPools(snatPools, now)
+		}
+	}
+
+	// scrape HTTP profile metrics, only when at least one of them is enabled
+	if s.httpProfileMetricsEnabled() {
+		profiles, err := c.GetHTTPProfiles(ctx)
+		if err != nil {
+			scrapeErrors.AddPartial(1, err)
+			s.logger.Warn("Failed to scrape HTTP profile metrics", zap.Error(err))
+		} else {
+			collectedMetrics = true
+			for key := range profiles.Entries {
+				profileStats := profiles.Entries[key]
+				s.collectHTTPProfiles(&profileStats, now)
+			}
+		}
+	}
+
+	// scrape device wide traffic metrics, only when at least one of them is enabled
+	if s.deviceMetricsEnabled() {
+		trafficStats, err := c.GetTrafficStats(ctx)
+		if err != nil {
+			scrapeErrors.AddPartial(1, err)
+			s.logger.Warn("Failed to scrape device traffic metrics", zap.Error(err))
+		} else {
+			collectedMetrics = true
+			for key := range trafficStats.Entries {
+				entry := trafficStats.Entries[key]
+				s.collectTrafficStats(&entry, now)
+			}
+		}
+	}
+
+	// scrape traffic management microkernel metrics, only when at least one of them is enabled
+	if s.tmmMetricsEnabled() {
+		tmmStats, err := c.GetTMMStats(ctx)
+		if err != nil {
+			scrapeErrors.AddPartial(1, err)
+			s.logger.Warn("Failed to scrape TMM metrics", zap.Error(err))
+		} else {
+			collectedMetrics = true
+			for key := range tmmStats.Entries {
+				entry := tmmStats.Entries[key]
+				s.collectTMMStats(&entry, now)
+			}
+			s.mb.EmitForResource()
+		}
+	}
+
+	// scrape system memory metrics, only when at least one of them is enabled
+	if s.memoryMetricsEnabled() {
+		systemStats, err := c.GetSystemStats(ctx)
+		if err != nil {
+			scrapeErrors.AddPartial(1, err)
+			s.logger.Warn("Failed to scrape system memory metrics", zap.Error(err))
+		} else {
+			collectedMetrics = true
+			s.collectSystemStats(systemStats, now)
+		}
+	}
+
+	// scrape network interface metrics, only when at least one of them is enabled
+	if s.interfaceMetricsEnabled() {
+		interfaces, err := c.GetInterfaces(ctx)
+		if err != nil {
+			scrapeErrors.AddPartial(1, err)
+			s.logger.Warn("Failed to scrape interface metrics", zap.Error(err))
+		} else {
+			collectedMetrics = true
+			for key := range interfaces.Entries {
+				entry := interfaces.Entries[key]
+				s.collectInterfaces(&entry, now)
+			}
+			s.mb.EmitForResource()
+		}
+	}
+
+	// scrape failover and config sync status metrics, only when at least one of them is enabled
+	if s.failoverMetricsEnabled() {
+		failoverStatus, err := c.GetFailoverStatus(ctx)
+		if err != nil {
+			scrapeErrors.AddPartial(1, err)
+			s.logger.Warn("Failed to scrape failover status metrics", zap.Error(err))
+		} else {
+			collectedMetrics = true
+			s.collectFailoverStatus(failoverStatus, now)
+		}
+	}
+
+	// scrape user defined custom metrics
+	customMetrics := pmetric.NewMetricSlice()
+	for i := range s.cfg.CustomMetrics {
+		customMetric := &s.cfg.CustomMetrics[i]
+		customStats, err := c.GetCustomStats(ctx, customMetric.Path)
+		if err != nil {
+			scrapeErrors.AddPartial(1, err)
+			s.logger.Warn("Failed to scrape custom metric", zap.String("metric", customMetric.Name), zap.Error(err))
+			continue
+		}
+		collectedMetrics = true
+		s.collectCustomMetric(customMetrics, customMetric, customStats, now)
+	}
+
 	if !collectedMetrics {
 		return pmetric.NewMetrics(), errScrapedNoMetrics
 	}
 
-	return s.mb.Emit(), scrapeErrors.Combine()
+	metrics := s.mb.Emit()
+	if customMetrics.Len() > 0 {
+		sm := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName(metadata.ScopeName)
+		sm.Scope().SetVersion(s.version)
+		customMetrics.MoveAndAppendTo(sm.Metrics())
+	}
+
+	return metrics, scrapeErrors.Combine()
+}
+
+// collectCustomMetric collects a user defined metric from the statistics of an arbitrary endpoint
+func (s *bigipScraper) collectCustomMetric(metrics pmetric.MetricSlice, customMetric *CustomMetricConfig, customStats *models.CustomStats, now pcommon.Timestamp) {
+	m := pmetric.NewMetric()
+	m.SetName(customMetric.Name)
+	m.SetDescription(customMetric.Description)
+	m.SetUnit(customMetric.Unit)
+
+	var dps pmetric.NumberDataPointSlice
+	if customMetric.Type == customMetricTypeCounter {
+		m.SetEmptySum()
+		m.Sum().SetIsMonotonic(true)
+		m.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		dps = m.Sum().DataPoints()
+	} else {
+		dps = m.SetEmptyGauge().DataPoints()
+	}
+
+	for url, entry := range customStats.Entries {
+		stat, ok := entry.NestedStats.Entries[customMetric.Field]
+		if !ok || stat.Value == nil {
+			s.logger.Debug("Custom metric field not found", zap.String("metric", customMetric.Name), zap.String("object", url))
+			continue
+		}
+
+		// prefer the object name reported by the device, falling back to its self link
+		name := url
+		if tmName, ok := entry.NestedStats.Entries["tmName"]; ok && tmName.Description != "" {
+			name = tmName.Description
+		}
+
+		dp := dps.AppendEmpty()
+		if customMetric.Type == customMetricTypeCounter {
+			dp.SetStartTimestamp(s.startTime)
+		}
+		dp.SetTimestamp(now)
+		dp.SetIntValue(*stat.Value)
+		dp.Attributes().PutStr("name", name)
+	}
+
+	if dps.Len() > 0 {
+		m.MoveTo(metrics.AppendEmpty())
+	}
+}
+
+// skipDisabled reports whether an object with the passed in enabled state is excluded from scraping
+func (s *bigipScraper) skipDisabled(enabledState string) bool {
+	return !s.cfg.IncludeDisabled && strings.HasPrefix(enabledState, "disabled")
+}
+
+// skipName reports whether an object with the passed in name is excluded by the partition or name filters
+func (s *bigipScraper) skipName(name string) bool {
+	if s.partitions != nil {
+		if _, ok := s.partitions[partitionName(name)]; !ok {
+			return true
+		}
+	}
+	if s.excludeFilter != nil && s.excludeFilter.MatchString(name) {
+		return true
+	}
+	return s.includeFilter != nil && !s.includeFilter.MatchString(name)
+}
+
+// getNewToken retrieves a new auth token, retrying failed attempts as long as the retry
+// fits within both the scrape context deadline and the collection interval
+func (s *bigipScraper) getNewToken(ctx context.Context, c client) error {
+	deadline := time.Now().Add(s.cfg.CollectionInterval)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := c.GetNewToken(ctx)
+		if err == nil || attempt >= s.cfg.LoginRetries || time.Now().Add(s.cfg.LoginRetryBackoff).After(deadline) {
+			return err
+		}
+
+		s.logger.Debug("Failed to retrieve api token, retrying", zap.Int("attempt", attempt+1), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(s.cfg.LoginRetryBackoff):
+		}
+	}
 }
 
 // collectVirtualServers collects virtual server metrics
@@ -230,6 +644,7 @@ func (s *bigipScraper) collectPoolMembers(poolMemberStats *models.PoolMemberStat
 	s.mb.RecordBigipPoolMemberSessionCountDataPoint(now, poolMemberStats.NestedStats.Entries.CurSessions.Value)
 
 	availability := poolMemberStats.NestedStats.Entries.AvailabilityState.Description
+	s.mb.RecordBigipPoolMemberMonitorStatusDataPoint(now, 1, availabilityStatus(availability), normalizeStatusReason(poolMemberStats.NestedStats.Entries.StatusReason.Description))
 	switch {
 	case strings.HasPrefix(availability, "available"):
 		s.mb.RecordBigipPoolMemberAvailabilityDataPoint(now, 0, metadata.AttributeAvailabilityStatusOffline)
@@ -255,12 +670,35 @@ func (s *bigipScraper) collectPoolMembers(poolMemberStats *models.PoolMemberStat
 	}
 
 	rb := s.mb.NewResourceBuilder()
-	rb.SetBigipPoolMemberName(fmt.Sprintf("%s:%d", poolMemberStats.NestedStats.Entries.Name.Description, poolMemberStats.NestedStats.Entries.Port.Value))
+	rb.SetBigipPoolMemberName(poolMemberName(poolMemberStats))
 	rb.SetBigipPoolMemberIPAddress(poolMemberStats.NestedStats.Entries.IPAddress.Description)
 	rb.SetBigipPoolName(poolMemberStats.NestedStats.Entries.PoolName.Description)
 	s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
+// availabilityStatus maps an availability state description to its attribute value
+func availabilityStatus(availability string) metadata.AttributeAvailabilityStatus {
+	switch {
+	case strings.HasPrefix(availability, "available"):
+		return metadata.AttributeAvailabilityStatusAvailable
+	case strings.HasPrefix(availability, "offline"):
+		return metadata.AttributeAvailabilityStatusOffline
+	default:
+		return metadata.AttributeAvailabilityStatusUnknown
+	}
+}
+
+// partitionName returns the administrative partition of an object from its full name, e.g. Common for /Common/pool
+func partitionName(name string) string {
+	partition, _, _ := strings.Cut(strings.TrimPrefix(name, "/"), "/")
+	return partition
+}
+
+// poolMemberName returns the name of a pool member, made of its node name and port
+func poolMemberName(poolMemberStats *models.PoolMemberStats) string {
+	return fmt.Sprintf("%s:%d", poolMemberStats.NestedStats.Entries.Name.Description, poolMemberStats.NestedStats.Entries.Port.Value)
+}
+
 // collectNodes collects node metrics
 func (s *bigipScraper) collectNodes(nodeStats *models.NodeStats, now pcommon.Timestamp) {
 	s.mb.RecordBigipNodeDataTransmittedDataPoint(now, nodeStats.NestedStats.Entries.ServersideBitsIn.Value, metadata.AttributeDirectionReceived)
@@ -301,3 +739,224 @@ func (s *bigipScraper) collectNodes(nodeStats *models.NodeStats, now pcommon.Tim
 	rb.SetBigipNodeIPAddress(nodeStats.NestedStats.Entries.IPAddress.Description)
 	s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
+
+// deviceMetricsEnabled reports whether any device wide traffic metric is enabled
+func (s *bigipScraper) deviceMetricsEnabled() bool {
+	metrics := s.cfg.Metrics
+	return metrics.BigipDeviceDataTransmitted.Enabled ||
+		metrics.BigipDeviceConnectionCount.Enabled ||
+		metrics.BigipDevicePacketCount.Enabled
+}
+
+// collectTrafficStats collects device wide traffic metrics
+func (s *bigipScraper) collectTrafficStats(trafficStats *models.TrafficStatsEntry, now pcommon.Timestamp) {
+	s.mb.RecordBigipDeviceDataTransmittedDataPoint(now, trafficStats.NestedStats.Entries.ClientsideBitsIn.Value, metadata.AttributeDirectionReceived)
+	s.mb.RecordBigipDeviceDataTransmittedDataPoint(now, trafficStats.NestedStats.Entries.ClientsideBitsOut.Value, metadata.AttributeDirectionSent)
+	s.mb.RecordBigipDeviceConnectionCountDataPoint(now, trafficStats.NestedStats.Entries.ClientsideCurConns.Value)
+	s.mb.RecordBigipDevicePacketCountDataPoint(now, trafficStats.NestedStats.Entries.ClientsidePktsIn.Value, metadata.AttributeDirectionReceived)
+	s.mb.RecordBigipDevicePacketCountDataPoint(now, trafficStats.NestedStats.Entries.ClientsidePktsOut.Value, metadata.AttributeDirectionSent)
+
+	s.mb.EmitForResource()
+}
+
+// sslProfileMetricsEnabled reports whether any client SSL profile metric is enabled
+func (s *bigipScraper) sslProfileMetricsEnabled() bool {
+	metrics := s.cfg.Metrics
+	return metrics.BigipSslProfileConnectionsCurrent.Enabled ||
+		metrics.BigipSslProfileHandshakes.Enabled ||
+		metrics.BigipSslProfileProtocolCount.Enabled ||
+		metrics.BigipSslProfileCipherCount.Enabled ||
+		metrics.BigipSslProfileKeyExchangeCount.Enabled
+}
+
+// collectClientSSLProfiles collects client SSL profile metrics
+func (s *bigipScraper) collectClientSSLProfiles(profileStats *models.ClientSSLProfileStats, now pcommon.Timestamp) {
+	entries := profileStats.NestedStats.Entries
+	if stat, ok := entries["common.currentConns"]; ok && stat.Value != nil {
+		s.mb.RecordBigipSslProfileConnectionsCurrentDataPoint(now, *stat.Value)
+	}
+	nativeConns, nativeOK := entries["common.totNativeConns"]
+	compatConns, compatOK := entries["common.totCompatConns"]
+	if (nativeOK && nativeConns.Value != nil) || (compatOK && compatConns.Value != nil) {
+		var handshakes int64
+		if nativeConns.Value != nil {
+			handshakes += *nativeConns.Value
+		}
+		if compatConns.Value != nil {
+			handshakes += *compatConns.Value
+		}
+		s.mb.RecordBigipSslProfileHandshakesDataPoint(now, handshakes, metadata.AttributeHandshakeResultSuccess)
+	}
+	if stat, ok := entries["common.handshakeFailures"]; ok && stat.Value != nil {
+		s.mb.RecordBigipSslProfileHandshakesDataPoint(now, *stat.Value, metadata.AttributeHandshakeResultFailure)
+	}
+	for field, protocol := range sslProtocols {
+		if stat, ok := entries[field]; ok && stat.Value != nil {
+			s.mb.RecordBigipSslProfileProtocolCountDataPoint(now, *stat.Value, protocol)
+		}
+	}
+	for field, cipher := range sslCiphers {
+		if stat, ok := entries[field]; ok && stat.Value != nil {
+			s.mb.RecordBigipSslProfileCipherCountDataPoint(now, *stat.Value, cipher)
+		}
+	}
+	for field, keyExchange := range sslKeyExchanges {
+		if stat, ok := entries[field]; ok && stat.Value != nil {
+			s.mb.RecordBigipSslProfileKeyExchangeCountDataPoint(now, *stat.Value, keyExchange)
+		}
+	}
+
+	rb := s.mb.NewResourceBuilder()
+	rb.SetBigipSslProfileName(entries["tmName"].Description)
+	s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+// snatPoolMetricsEnabled reports whether any SNAT pool metric is enabled
+func (s *bigipScraper) snatPoolMetricsEnabled() bool {
+	metrics := s.cfg.Metrics
+	return metrics.BigipSnatpoolConnections.Enabled ||
+		metrics.BigipSnatpoolTranslationAddressCount.Enabled
+}
+
+// collectSNATPools collects SNAT pool metrics
+func (s *bigipScraper) collectSNATPools(snatPools *models.SNATPools, now pcommon.Timestamp) {
+	for _, pool := range snatPools.Pools {
+		s.mb.RecordBigipSnatpoolConnectionsDataPoint(now, pool.Connections, pool.Name)
+		s.mb.RecordBigipSnatpoolTranslationAddressCountDataPoint(now, pool.TranslationAddresses, pool.Name)
+	}
+
+	s.mb.EmitForResource()
+}
+
+// httpProfileMetricsEnabled reports whether any HTTP profile metric is enabled
+func (s *bigipScraper) httpProfileMetricsEnabled() bool {
+	return s.cfg.Metrics.BigipHTTPResponses.Enabled
+}
+
+// collectHTTPProfiles collects HTTP profile metrics
+func (s *bigipScraper) collectHTTPProfiles(profileStats *models.HTTPProfileStats, now pcommon.Timestamp) {
+	entries := profileStats.NestedStats.Entries
+	s.mb.RecordBigipHTTPResponsesDataPoint(now, entries.Resp2xxCnt.Value, metadata.AttributeCodeClass2xx)
+	s.mb.RecordBigipHTTPResponsesDataPoint(now, entries.Resp3xxCnt.Value, metadata.AttributeCodeClass3xx)
+	s.mb.RecordBigipHTTPResponsesDataPoint(now, entries.Resp4xxCnt.Value, metadata.AttributeCodeClass4xx)
+	s.mb.RecordBigipHTTPResponsesDataPoint(now, entries.Resp5xxCnt.Value, metadata.AttributeCodeClass5xx)
+
+	rb := s.mb.NewResourceBuilder()
+	rb.SetBigipHTTPProfileName(entries.Name.Description)
+	s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+// tmmMetricsEnabled reports whether any traffic management microkernel metric is enabled
+func (s *bigipScraper) tmmMetricsEnabled() bool {
+	return s.cfg.Metrics.BigipSystemTmmCPUUtilization.Enabled
+}
+
+// collectTMMStats collects traffic management microkernel metrics
+func (s *bigipScraper) collectTMMStats(tmmStats *models.TMMStatsEntry, now pcommon.Timestamp) {
+	entries := tmmStats.NestedStats.Entries
+	s.mb.RecordBigipSystemTmmCPUUtilizationDataPoint(now, float64(entries.FiveSecAvgUsageRatio.Value)/100, entries.TMMID.Description)
+}
+
+// memoryMetricsEnabled reports whether any system memory metric is enabled
+func (s *bigipScraper) memoryMetricsEnabled() bool {
+	metrics := s.cfg.Metrics
+	return metrics.BigipSystemMemoryUsed.Enabled ||
+		metrics.BigipSystemMemoryTotal.Enabled
+}
+
+// collectSystemStats collects system memory metrics, summed up over all hosts of the device
+func (s *bigipScraper) collectSystemStats(systemStats *models.SystemStats, now pcommon.Timestamp) {
+	var hosts, tmmTotal, tmmUsed, otherTotal, otherUsed int64
+	for _, group := range systemStats.Entries {
+		for key := range group.NestedStats.Entries {
+			hosts++
+			entries := group.NestedStats.Entries[key].NestedStats.Entries
+			tmmTotal += entries.TmmMemoryTotal.Value
+			tmmUsed += entries.TmmMemoryUsed.Value
+			otherTotal += entries.OtherMemoryTotal.Value
+			otherUsed += entries.OtherMemoryUsed.Value
+		}
+	}
+	if hosts == 0 {
+		return
+	}
+
+	s.mb.RecordBigipSystemMemoryTotalDataPoint(now, tmmTotal, metadata.AttributeMemoryTypeTmm)
+	s.mb.RecordBigipSystemMemoryTotalDataPoint(now, otherTotal, metadata.AttributeMemoryTypeOther)
+	s.mb.RecordBigipSystemMemoryUsedDataPoint(now, tmmUsed, metadata.AttributeMemoryTypeTmm)
+	s.mb.RecordBigipSystemMemoryUsedDataPoint(now, otherUsed, metadata.AttributeMemoryTypeOther)
+
+	s.mb.EmitForResource()
+}
+
+// interfaceMetricsEnabled reports whether any network interface metric is enabled
+func (s *bigipScraper) interfaceMetricsEnabled() bool {
+	metrics := s.cfg.Metrics
+	return metrics.BigipNetInterfaceBitsReceived.Enabled ||
+		metrics.BigipNetInterfaceBitsSent.Enabled ||
+		metrics.BigipNetInterfaceDropsReceived.Enabled ||
+		metrics.BigipNetInterfaceDropsSent.Enabled
+}
+
+// collectInterfaces collects network interface metrics
+func (s *bigipScraper) collectInterfaces(interfaceStats *models.InterfaceStatsEntry, now pcommon.Timestamp) {
+	entries := interfaceStats.NestedStats.Entries
+	s.mb.RecordBigipNetInterfaceBitsReceivedDataPoint(now, entries.BitsIn.Value, entries.Name.Description)
+	s.mb.RecordBigipNetInterfaceBitsSentDataPoint(now, entries.BitsOut.Value, entries.Name.Description)
+	s.mb.RecordBigipNetInterfaceDropsReceivedDataPoint(now, entries.DropsIn.Value, entries.Name.Description)
+	s.mb.RecordBigipNetInterfaceDropsSentDataPoint(now, entries.DropsOut.Value, entries.Name.Description)
+}
+
+// syncStatuses lists every config sync status, so that the current one can be reported as 1 and the others as 0
+var syncStatuses = []metadata.AttributeSyncStatus{
+	metadata.AttributeSyncStatusInSync,
+	metadata.AttributeSyncStatusChangesPending,
+	metadata.AttributeSyncStatusAwaitingInitialSync,
+	metadata.AttributeSyncStatusNotAllDevicesSynced,
+	metadata.AttributeSyncStatusSyncing,
+	metadata.AttributeSyncStatusSyncFailure,
+	metadata.AttributeSyncStatusDisconnected,
+	metadata.AttributeSyncStatusStandalone,
+	metadata.AttributeSyncStatusUnknown,
+}
+
+// failoverMetricsEnabled reports whether any failover or config sync status metric is enabled
+func (s *bigipScraper) failoverMetricsEnabled() bool {
+	metrics := s.cfg.Metrics
+	return metrics.BigipCmFailoverActive.Enabled ||
+		metrics.BigipCmSyncStatus.Enabled
+}
+
+// collectFailoverStatus collects failover and config sync status metrics
+func (s *bigipScraper) collectFailoverStatus(failoverStatus *models.FailoverStatus, now pcommon.Timestamp) {
+	var active int64
+	if strings.EqualFold(failoverStatus.FailoverStatus, "ACTIVE") {
+		active = 1
+	}
+	s.mb.RecordBigipCmFailoverActiveDataPoint(now, active, failoverStatus.Device)
+
+	// the Big-IP reports statuses such as "In Sync" or "Changes Pending"
+	current, ok := metadata.MapAttributeSyncStatus[strings.ReplaceAll(strings.ToLower(failoverStatus.SyncStatus), " ", "_")]
+	if !ok {
+		current = metadata.AttributeSyncStatusUnknown
+	}
+	for _, status := range syncStatuses {
+		var value int64
+		if status == current {
+			value = 1
+		}
+		s.mb.RecordBigipCmSyncStatusDataPoint(now, value, failoverStatus.Device, status)
+	}
+
+	s.mb.EmitForResource()
+}
+
+// normalizeStatusReason removes the check timestamps and redundant whitespace from a monitor status reason
+// and truncates it, so that the reason only changes when the monitor result does.
+func normalizeStatusReason(reason string) string {
+	reason = strings.Join(strings.Fields(statusReasonTimestamp.ReplaceAllString(reason, "")), " ")
+	if runes := []rune(reason); len(runes) > maxStatusReasonLength {
+		reason = string(runes[:maxStatusReasonLength])
+	}
+	return reason
+}
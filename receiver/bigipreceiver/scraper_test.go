@@ -5,11 +5,18 @@ package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -40,10 +47,27 @@ func TestScraperStart(t *testing.T) {
 		},
 	}
 
+	// the self-signed certificate of the test server is only accepted through the pinned fingerprint
+	loginData := loadAPIResponseData(t, loginResponseFile)
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write(loginData)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+	certSum := sha256.Sum256(ts.Certificate().Raw)
+	mismatchingSum := sha256.Sum256([]byte("some other certificate"))
+
+	clientConfigPinned := confighttp.NewDefaultClientConfig()
+	clientConfigPinned.TLSSetting = configtls.ClientConfig{}
+	clientConfigPinned.Endpoint = ts.URL
+
 	testcases := []struct {
 		desc        string
 		scraper     *bigipScraper
 		expectError bool
+		// checkLogin makes a login after the start, which is expected to fail with expectLoginErr when it is set
+		checkLogin     bool
+		expectLoginErr string
 	}{
 		{
 			desc: "Bad Config",
@@ -65,6 +89,48 @@ func TestScraperStart(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			desc: "Valid Config With Endpoints",
+			scraper: &bigipScraper{
+				cfg: &Config{
+					ClientConfig: clientConfig,
+					Endpoints: []DeviceConfig{
+						{Endpoint: "https://bigip-a:443"},
+						{Endpoint: "https://bigip-b:443"},
+					},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+				logger:   zap.NewNop(),
+			},
+			expectError: false,
+		},
+		{
+			desc: "Matching Pinned Cert",
+			scraper: &bigipScraper{
+				cfg: &Config{
+					ClientConfig:     clientConfigPinned,
+					PinnedCertSHA256: hex.EncodeToString(certSum[:]),
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+				logger:   zap.NewNop(),
+			},
+			expectError: false,
+			checkLogin:  true,
+		},
+		{
+			desc: "Mismatching Pinned Cert",
+			scraper: &bigipScraper{
+				cfg: &Config{
+					ClientConfig:     clientConfigPinned,
+					PinnedCertSHA256: hex.EncodeToString(mismatchingSum[:]),
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+				logger:   zap.NewNop(),
+			},
+			expectError:    false,
+			checkLogin:     true,
+			expectLoginErr: "does not match the pinned fingerprint",
+		},
 	}
 
 	for _, tc := range testcases {
@@ -75,6 +141,67 @@ func TestScraperStart(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+
+			if tc.checkLogin {
+				err = tc.scraper.client.GetNewToken(context.Background())
+				if tc.expectLoginErr == "" {
+					require.NoError(t, err)
+				} else {
+					require.ErrorContains(t, err, tc.expectLoginErr)
+				}
+			}
+		})
+	}
+}
+
+func TestScraperShutdown(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		logoutOnShutdown bool
+		hasToken         bool
+		deleteErr        error
+		expectedDeletes  int
+	}{
+		{
+			desc:             "Token is deleted",
+			logoutOnShutdown: true,
+			hasToken:         true,
+			expectedDeletes:  1,
+		},
+		{
+			desc:             "Delete failure does not fail shutdown",
+			logoutOnShutdown: true,
+			hasToken:         true,
+			deleteErr:        errors.New("some token api error"),
+			expectedDeletes:  1,
+		},
+		{
+			desc:             "No token to delete",
+			logoutOnShutdown: true,
+			hasToken:         false,
+			expectedDeletes:  0,
+		},
+		{
+			desc:             "Logout disabled",
+			logoutOnShutdown: false,
+			hasToken:         true,
+			expectedDeletes:  0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockClient := mocks.MockClient{}
+			mockClient.On("HasToken").Return(tc.hasToken)
+			mockClient.On("DeleteToken", mock.Anything).Return(tc.deleteErr)
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.LogoutOnShutdown = tc.logoutOnShutdown
+			scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopSettings(metadata.Type))
+			scraper.client = &mockClient
+
+			require.NoError(t, scraper.shutdown(context.Background()))
+			mockClient.AssertNumberOfCalls(t, "DeleteToken", tc.expectedDeletes)
 		})
 	}
 }
@@ -83,6 +210,7 @@ func TestScraperScrape(t *testing.T) {
 	testCases := []struct {
 		desc              string
 		setupMockClient   func(t *testing.T) client
+		setupConfig       func(cfg *Config)
 		expectedMetricGen func(t *testing.T) pmetric.Metrics
 		expectedErr       error
 	}{
@@ -101,8 +229,12 @@ func TestScraperScrape(t *testing.T) {
 			setupMockClient: func(*testing.T) client {
 				mockClient := mocks.MockClient{}
 				mockClient.On("GetNewToken", mock.Anything).Return(errors.New("some api error"))
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
 				return &mockClient
 			},
+			setupConfig: func(cfg *Config) {
+				cfg.LoginRetryBackoff = time.Millisecond
+			},
 			expectedMetricGen: func(*testing.T) pmetric.Metrics {
 				return pmetric.NewMetrics()
 			},
@@ -113,6 +245,7 @@ func TestScraperScrape(t *testing.T) {
 			setupMockClient: func(*testing.T) client {
 				mockClient := mocks.MockClient{}
 				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
 				mockClient.On("GetVirtualServers", mock.Anything).Return(nil, errors.New("some virtual api error"))
 				mockClient.On("GetPools", mock.Anything).Return(nil, errors.New("some pool api error"))
 				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(nil, errCollectedNoPoolMembers)
@@ -129,6 +262,7 @@ func TestScraperScrape(t *testing.T) {
 			setupMockClient: func(*testing.T) client {
 				mockClient := mocks.MockClient{}
 				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
 				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
 				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
 				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
@@ -148,6 +282,7 @@ func TestScraperScrape(t *testing.T) {
 			setupMockClient: func(t *testing.T) client {
 				mockClient := mocks.MockClient{}
 				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
 
 				// use helper function from client tests
 				data := loadAPIResponseData(t, virtualServersCombinedFile)
@@ -175,6 +310,7 @@ func TestScraperScrape(t *testing.T) {
 			setupMockClient: func(t *testing.T) client {
 				mockClient := mocks.MockClient{}
 				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
 
 				// use helper function from client tests
 				data := loadAPIResponseData(t, virtualServersCombinedFile)
@@ -211,64 +347,967 @@ func TestScraperScrape(t *testing.T) {
 		},
 		{
 			desc: "Successful Full Collection",
+			setupMockClient: func(t *testing.T) client {
+				return fullCollectionMockClient(t)
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "Successful Full Collection With Name Filters",
+			setupMockClient: func(t *testing.T) client {
+				return fullCollectionMockClient(t)
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.IncludeNameFilter = "^/Common/"
+				cfg.ExcludeNameFilter = "-2$|nginx"
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_name_filter_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "Successful Full Collection With Partition Filter",
+			setupMockClient: func(t *testing.T) client {
+				return fullCollectionMockClient(t)
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Partitions = []string{"stage"}
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_partition_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "Successful Full Collection Excluding Disabled Objects",
+			setupMockClient: func(t *testing.T) client {
+				return fullCollectionMockClient(t)
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.IncludeDisabled = false
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_exclude_disabled_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "Successful Full Collection With Pool Member Monitor Status",
+			setupMockClient: func(t *testing.T) client {
+				return fullCollectionMockClient(t)
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipPoolMemberMonitorStatus.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_monitor_status_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "Successful Full Collection With System Memory",
+			setupMockClient: func(t *testing.T) client {
+				return fullCollectionMockClient(t)
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipSystemMemoryUsed.Enabled = true
+				cfg.Metrics.BigipSystemMemoryTotal.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_memory_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "System Memory API Call Failure",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(nil, errors.New("some pool api error"))
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetSystemStats", mock.Anything).Return(nil, errors.New("some memory api error"))
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipSystemMemoryUsed.Enabled = true
+			},
+			expectedMetricGen: func(*testing.T) pmetric.Metrics {
+				return pmetric.NewMetrics()
+			},
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("some pool api error; some memory api error"), 0),
+		},
+		{
+			desc: "Successful Pool Member Availability Collection",
 			setupMockClient: func(t *testing.T) client {
 				mockClient := mocks.MockClient{}
 				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
 
-				// use helper function from client tests
-				data := loadAPIResponseData(t, virtualServersCombinedFile)
-				var virtualServers *models.VirtualServers
-				err := json.Unmarshal(data, &virtualServers)
+				// use helper function from client tests, the test data has no member of unknown availability
+				data := loadAPIResponseData(t, poolMembersCombinedFile)
+				var poolMembers *models.PoolMembers
+				err := json.Unmarshal(data, &poolMembers)
 				require.NoError(t, err)
-				mockClient.On("GetVirtualServers", mock.Anything).Return(virtualServers, nil)
+				for key, poolMemberStats := range poolMembers.Entries {
+					if poolMemberName(&poolMemberStats) == "/Common/test-node-3:80" {
+						poolMemberStats.NestedStats.Entries.AvailabilityState.Description = "unknown"
+						poolMembers.Entries[key] = poolMemberStats
+					}
+				}
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(poolMembers, nil)
 
-				// use helper function from client tests
-				data = loadAPIResponseData(t, poolsStatsResponseFile)
-				var pools *models.Pools
-				err = json.Unmarshal(data, &pools)
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics = metadata.MetricsConfig{
+					BigipPoolMemberAvailability: metadata.MetricConfig{Enabled: true},
+				}
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_pool_member_availability_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
 				require.NoError(t, err)
-				mockClient.On("GetPools", mock.Anything).Return(pools, nil)
+				return expectedMetrics
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "Successful Custom Metric Collection",
+			setupMockClient: func(t *testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
 
 				// use helper function from client tests
-				data = loadAPIResponseData(t, poolMembersCombinedFile)
-				var poolMembers *models.PoolMembers
-				err = json.Unmarshal(data, &poolMembers)
+				data := loadAPIResponseData(t, nodesStatsResponseFile)
+				var customStats *models.CustomStats
+				err := json.Unmarshal(data, &customStats)
 				require.NoError(t, err)
-				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(poolMembers, nil)
+				mockClient.On("GetCustomStats", mock.Anything, nodesStatsPath).Return(customStats, nil)
+				mockClient.On("GetCustomStats", mock.Anything, "/mgmt/tm/sys/missing/stats").Return(nil, errors.New("some custom api error"))
+
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.CustomMetrics = []CustomMetricConfig{
+					{
+						Name:        "bigip.node.max_connections",
+						Description: "Maximum number of connections to the node.",
+						Unit:        "{connections}",
+						Path:        nodesStatsPath,
+						Field:       "serverside.maxConns",
+						Type:        customMetricTypeGauge,
+					},
+					{
+						Name:        "bigip.node.total_connections",
+						Description: "Total number of connections to the node.",
+						Unit:        "{connections}",
+						Path:        nodesStatsPath,
+						Field:       "serverside.totConns",
+						Type:        customMetricTypeCounter,
+					},
+					{
+						Name:  "bigip.missing",
+						Path:  "/mgmt/tm/sys/missing/stats",
+						Field: "value",
+						Type:  customMetricTypeGauge,
+					},
+				}
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_custom_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("some custom api error"), 0),
+		},
+		{
+			desc: "Successful Client SSL Profile Collection",
+			setupMockClient: func(t *testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
 
 				// use helper function from client tests
-				data = loadAPIResponseData(t, nodesStatsResponseFile)
-				var nodes *models.Nodes
-				err = json.Unmarshal(data, &nodes)
+				data := loadAPIResponseData(t, clientSSLProfilesResponseFile)
+				var profiles *models.ClientSSLProfiles
+				err := json.Unmarshal(data, &profiles)
 				require.NoError(t, err)
-				mockClient.On("GetNodes", mock.Anything).Return(nodes, nil)
+				mockClient.On("GetClientSSLProfiles", mock.Anything).Return(profiles, nil)
 
 				return &mockClient
 			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipSslProfileConnectionsCurrent.Enabled = true
+				cfg.Metrics.BigipSslProfileHandshakes.Enabled = true
+				cfg.Metrics.BigipSslProfileProtocolCount.Enabled = true
+				cfg.Metrics.BigipSslProfileCipherCount.Enabled = true
+				cfg.Metrics.BigipSslProfileKeyExchangeCount.Enabled = true
+			},
 			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
-				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_golden.yaml")
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_ssl_profiles_golden.yaml")
 				expectedMetrics, err := golden.ReadMetrics(goldenPath)
 				require.NoError(t, err)
 				return expectedMetrics
 			},
-			expectedErr: nil,
 		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.desc, func(t *testing.T) {
-			scraper := newScraper(zap.NewNop(), createDefaultConfig().(*Config), receivertest.NewNopSettings(metadata.Type))
-			scraper.client = tc.setupMockClient(t)
+		{
+			desc: "Client SSL Profile API Call Failure",
+			setupMockClient: func(t *testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetClientSSLProfiles", mock.Anything).Return(nil, errors.New("some ssl api error"))
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipSslProfileProtocolCount.Enabled = true
+			},
+			expectedMetricGen: func(*testing.T) pmetric.Metrics {
+				return pmetric.NewMetrics()
+			},
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("some ssl api error"), 0),
+		},
+		{
+			desc: "Successful Device Traffic Collection",
+			setupMockClient: func(t *testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
 
-			actualMetrics, err := scraper.scrape(context.Background())
+				// use helper function from client tests
+				data := loadAPIResponseData(t, trafficStatsResponseFile)
+				var trafficStats *models.TrafficStats
+				err := json.Unmarshal(data, &trafficStats)
+				require.NoError(t, err)
+				mockClient.On("GetTrafficStats", mock.Anything).Return(trafficStats, nil)
 
-			if tc.expectedErr == nil {
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipDeviceDataTransmitted.Enabled = true
+				cfg.Metrics.BigipDeviceConnectionCount.Enabled = true
+				cfg.Metrics.BigipDevicePacketCount.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_device_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
 				require.NoError(t, err)
-			} else {
-				require.EqualError(t, err, tc.expectedErr.Error())
-			}
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "Device Traffic API Call Failure",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetTrafficStats", mock.Anything).Return(nil, errors.New("some traffic api error"))
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipDeviceConnectionCount.Enabled = true
+			},
+			expectedMetricGen: func(*testing.T) pmetric.Metrics {
+				return pmetric.NewMetrics()
+			},
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("some traffic api error"), 0),
+		},
+		{
+			desc: "Successful TMM Collection",
+			setupMockClient: func(t *testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
 
-			expectedMetrics := tc.expectedMetricGen(t)
+				// use helper function from client tests
+				data := loadAPIResponseData(t, tmmStatsResponseFile)
+				var tmmStats *models.TMMStats
+				err := json.Unmarshal(data, &tmmStats)
+				require.NoError(t, err)
+				mockClient.On("GetTMMStats", mock.Anything).Return(tmmStats, nil)
+
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipSystemTmmCPUUtilization.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_tmm_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "Successful TMM Empty Collection",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetTMMStats", mock.Anything).Return(&models.TMMStats{}, nil)
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipSystemTmmCPUUtilization.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_tmm_empty_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "TMM API Call Failure",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetTMMStats", mock.Anything).Return(nil, errors.New("some tmm api error"))
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipSystemTmmCPUUtilization.Enabled = true
+			},
+			expectedMetricGen: func(*testing.T) pmetric.Metrics {
+				return pmetric.NewMetrics()
+			},
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("some tmm api error"), 0),
+		},
+		{
+			desc: "Successful Interface Collection",
+			setupMockClient: func(t *testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+
+				// use helper function from client tests
+				data := loadAPIResponseData(t, interfaceStatsResponseFile)
+				var interfaces *models.InterfaceStats
+				err := json.Unmarshal(data, &interfaces)
+				require.NoError(t, err)
+				mockClient.On("GetInterfaces", mock.Anything).Return(interfaces, nil)
+
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipNetInterfaceBitsReceived.Enabled = true
+				cfg.Metrics.BigipNetInterfaceBitsSent.Enabled = true
+				cfg.Metrics.BigipNetInterfaceDropsReceived.Enabled = true
+				cfg.Metrics.BigipNetInterfaceDropsSent.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_interface_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "Successful Interface Empty Collection",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetInterfaces", mock.Anything).Return(&models.InterfaceStats{}, nil)
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipNetInterfaceBitsReceived.Enabled = true
+				cfg.Metrics.BigipNetInterfaceBitsSent.Enabled = true
+				cfg.Metrics.BigipNetInterfaceDropsReceived.Enabled = true
+				cfg.Metrics.BigipNetInterfaceDropsSent.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_interface_empty_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "Interface API Call Failure",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetInterfaces", mock.Anything).Return(nil, errors.New("some interface api error"))
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipNetInterfaceBitsReceived.Enabled = true
+				cfg.Metrics.BigipNetInterfaceBitsSent.Enabled = true
+				cfg.Metrics.BigipNetInterfaceDropsReceived.Enabled = true
+				cfg.Metrics.BigipNetInterfaceDropsSent.Enabled = true
+			},
+			expectedMetricGen: func(*testing.T) pmetric.Metrics {
+				return pmetric.NewMetrics()
+			},
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("some interface api error"), 0),
+		},
+		{
+			desc: "Successful SNAT Pool Collection",
+			setupMockClient: func(t *testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+
+				// use helper function from client tests
+				data := loadAPIResponseData(t, snatPoolsCombinedFile)
+				var snatPools *models.SNATPools
+				err := json.Unmarshal(data, &snatPools)
+				require.NoError(t, err)
+				mockClient.On("GetSNATPools", mock.Anything).Return(snatPools, nil)
+
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipSnatpoolConnections.Enabled = true
+				cfg.Metrics.BigipSnatpoolTranslationAddressCount.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_snat_pools_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "Successful SNAT Pool Empty Collection",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetSNATPools", mock.Anything).Return(&models.SNATPools{}, nil)
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipSnatpoolConnections.Enabled = true
+				cfg.Metrics.BigipSnatpoolTranslationAddressCount.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_snat_pools_empty_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "SNAT Pool API Call Failure",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetSNATPools", mock.Anything).Return(nil, errors.New("some snat api error"))
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipSnatpoolConnections.Enabled = true
+				cfg.Metrics.BigipSnatpoolTranslationAddressCount.Enabled = true
+			},
+			expectedMetricGen: func(*testing.T) pmetric.Metrics {
+				return pmetric.NewMetrics()
+			},
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("some snat api error"), 0),
+		},
+		{
+			desc: "Successful HTTP Profile Collection",
+			setupMockClient: func(t *testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+
+				// use helper function from client tests
+				data := loadAPIResponseData(t, httpProfilesResponseFile)
+				var profiles *models.HTTPProfiles
+				err := json.Unmarshal(data, &profiles)
+				require.NoError(t, err)
+				mockClient.On("GetHTTPProfiles", mock.Anything).Return(profiles, nil)
+
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipHTTPResponses.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_http_profiles_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "Successful HTTP Profile Empty Collection",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetHTTPProfiles", mock.Anything).Return(&models.HTTPProfiles{}, nil)
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipHTTPResponses.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_http_profiles_empty_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "HTTP Profile API Call Failure",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetHTTPProfiles", mock.Anything).Return(nil, errors.New("some http api error"))
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipHTTPResponses.Enabled = true
+			},
+			expectedMetricGen: func(*testing.T) pmetric.Metrics {
+				return pmetric.NewMetrics()
+			},
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("some http api error"), 0),
+		},
+		{
+			desc: "Successful Failover Status Collection",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetFailoverStatus", mock.Anything).Return(&models.FailoverStatus{
+					Device:         "bigip-a.example.com",
+					FailoverStatus: "ACTIVE",
+					SyncStatus:     "In Sync",
+				}, nil)
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipCmFailoverActive.Enabled = true
+				cfg.Metrics.BigipCmSyncStatus.Enabled = true
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_failover_status_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "Failover Status API Call Failure",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("HasValidToken", mock.Anything).Return(false)
+				mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+				mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+				mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+				mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+				mockClient.On("GetFailoverStatus", mock.Anything).Return(nil, errors.New("some failover api error"))
+				return &mockClient
+			},
+			setupConfig: func(cfg *Config) {
+				cfg.Metrics.BigipCmFailoverActive.Enabled = true
+				cfg.Metrics.BigipCmSyncStatus.Enabled = true
+			},
+			expectedMetricGen: func(*testing.T) pmetric.Metrics {
+				return pmetric.NewMetrics()
+			},
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("some failover api error"), 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			if tc.setupConfig != nil {
+				tc.setupConfig(cfg)
+			}
+			scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopSettings(metadata.Type))
+			scraper.client = tc.setupMockClient(t)
+
+			actualMetrics, err := scraper.scrape(context.Background())
+
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			}
+
+			expectedMetrics := tc.expectedMetricGen(t)
+
+			err = pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+				pmetrictest.IgnoreMetricDataPointsOrder(),
+				pmetrictest.IgnoreResourceMetricsOrder(), pmetrictest.IgnoreStartTimestamp(),
+				pmetrictest.IgnoreTimestamp())
+			require.NoError(t, err)
+		})
+	}
+}
+
+// fullCollectionMockClient returns a mock client serving the full set of test API responses
+func fullCollectionMockClient(t *testing.T) *mocks.MockClient {
+	mockClient := mocks.MockClient{}
+	mockClient.On("GetNewToken", mock.Anything).Return(nil)
+	mockClient.On("HasValidToken", mock.Anything).Return(false)
+
+	// use helper function from client tests
+	data := loadAPIResponseData(t, virtualServersCombinedFile)
+	var virtualServers *models.VirtualServers
+	err := json.Unmarshal(data, &virtualServers)
+	require.NoError(t, err)
+	mockClient.On("GetVirtualServers", mock.Anything).Return(virtualServers, nil)
+
+	// use helper function from client tests
+	data = loadAPIResponseData(t, poolsStatsResponseFile)
+	var pools *models.Pools
+	err = json.Unmarshal(data, &pools)
+	require.NoError(t, err)
+	mockClient.On("GetPools", mock.Anything).Return(pools, nil)
+
+	// use helper function from client tests
+	data = loadAPIResponseData(t, poolMembersCombinedFile)
+	var poolMembers *models.PoolMembers
+	err = json.Unmarshal(data, &poolMembers)
+	require.NoError(t, err)
+	mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(poolMembers, nil)
+
+	// use helper function from client tests
+	data = loadAPIResponseData(t, nodesStatsResponseFile)
+	var nodes *models.Nodes
+	err = json.Unmarshal(data, &nodes)
+	require.NoError(t, err)
+	mockClient.On("GetNodes", mock.Anything).Return(nodes, nil)
+
+	// use helper function from client tests
+	data = loadAPIResponseData(t, systemStatsResponseFile)
+	var systemStats *models.SystemStats
+	err = json.Unmarshal(data, &systemStats)
+	require.NoError(t, err)
+	mockClient.On("GetSystemStats", mock.Anything).Return(systemStats, nil)
+
+	return &mockClient
+}
+
+func TestScraperLoginRetry(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		loginRetries     int
+		backoff          time.Duration
+		expectedAttempts int
+		expectedErr      error
+	}{
+		{
+			desc:             "Transient failure is retried",
+			loginRetries:     2,
+			backoff:          time.Millisecond,
+			expectedAttempts: 2,
+		},
+		{
+			desc:             "Retries disabled",
+			loginRetries:     0,
+			backoff:          time.Millisecond,
+			expectedAttempts: 1,
+			expectedErr:      errors.New("some transient api error"),
+		},
+		{
+			desc:             "Backoff exceeds collection interval",
+			loginRetries:     2,
+			backoff:          time.Minute,
+			expectedAttempts: 1,
+			expectedErr:      errors.New("some transient api error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockClient := mocks.MockClient{}
+			mockClient.On("HasValidToken", mock.Anything).Return(false)
+			mockClient.On("GetNewToken", mock.Anything).Return(errors.New("some transient api error")).Once()
+			mockClient.On("GetNewToken", mock.Anything).Return(nil)
+			mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+			mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+			mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+			mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.LoginRetries = tc.loginRetries
+			cfg.LoginRetryBackoff = tc.backoff
+			scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopSettings(metadata.Type))
+			scraper.client = &mockClient
+
+			_, err := scraper.scrape(context.Background())
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			}
+			mockClient.AssertNumberOfCalls(t, "GetNewToken", tc.expectedAttempts)
+		})
+	}
+}
+
+func TestScraperTokenReuse(t *testing.T) {
+	mockClient := mocks.MockClient{}
+	// the token retrieved on the first scrape is still valid on the second one
+	mockClient.On("HasValidToken", time.Minute).Return(false).Once()
+	mockClient.On("HasValidToken", time.Minute).Return(true)
+	mockClient.On("GetNewToken", mock.Anything).Return(nil)
+	mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+	mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+	mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+	mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.TokenRefreshBuffer = time.Minute
+	scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopSettings(metadata.Type))
+	scraper.client = &mockClient
+
+	_, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	_, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	mockClient.AssertNumberOfCalls(t, "HasValidToken", 2)
+	mockClient.AssertNumberOfCalls(t, "GetNewToken", 1)
+}
+
+func TestScraperConcurrentErrorAggregation(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		poolDelay time.Duration
+		nodeDelay time.Duration
+	}{
+		{
+			desc:      "Pools Fail First",
+			poolDelay: 0,
+			nodeDelay: 50 * time.Millisecond,
+		},
+		{
+			desc:      "Nodes Fail First",
+			poolDelay: 50 * time.Millisecond,
+			nodeDelay: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockClient := mocks.MockClient{}
+			mockClient.On("GetNewToken", mock.Anything).Return(nil)
+			mockClient.On("HasValidToken", mock.Anything).Return(false)
+			mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+			mockClient.On("GetPools", mock.Anything).After(tc.poolDelay).Return(nil, errors.New("some pool api error"))
+			mockClient.On("GetNodes", mock.Anything).After(tc.nodeDelay).Return(nil, errors.New("some node api error"))
+
+			scraper := newScraper(zap.NewNop(), createDefaultConfig().(*Config), receivertest.NewNopSettings(metadata.Type))
+			scraper.client = &mockClient
+
+			_, err := scraper.scrape(context.Background())
+			// the aggregated error does not depend on the order in which the calls complete
+			require.EqualError(t, err, "some pool api error; some node api error")
+			require.True(t, scrapererror.IsPartialScrapeError(err))
+		})
+	}
+}
+
+func TestScraperScrapeMultipleDevices(t *testing.T) {
+	// interfaceMockClient returns a mock client of a device only reporting its network interfaces
+	interfaceMockClient := func(t *testing.T) *mocks.MockClient {
+		mockClient := mocks.MockClient{}
+		mockClient.On("GetNewToken", mock.Anything).Return(nil)
+		mockClient.On("HasValidToken", mock.Anything).Return(false)
+		mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+		mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+		mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+		mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+
+		// use helper function from client tests
+		data := loadAPIResponseData(t, interfaceStatsResponseFile)
+		var interfaces *models.InterfaceStats
+		err := json.Unmarshal(data, &interfaces)
+		require.NoError(t, err)
+		mockClient.On("GetInterfaces", mock.Anything).Return(interfaces, nil)
+		return &mockClient
+	}
+	// failingMockClient returns a mock client of a device rejecting the login
+	failingMockClient := func(*testing.T) *mocks.MockClient {
+		mockClient := mocks.MockClient{}
+		mockClient.On("GetNewToken", mock.Anything).Return(errors.New("some api error"))
+		mockClient.On("HasValidToken", mock.Anything).Return(false)
+		return &mockClient
+	}
+
+	testCases := []struct {
+		desc              string
+		setupMockClients  func(*testing.T) (client, client)
+		expectedMetricGen func(*testing.T) pmetric.Metrics
+		expectedErr       error
+	}{
+		{
+			desc: "Successful Collection From All Devices",
+			setupMockClients: func(t *testing.T) (client, client) {
+				return interfaceMockClient(t), interfaceMockClient(t)
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_multiple_devices_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+		},
+		{
+			desc: "Single Device Failure",
+			setupMockClients: func(t *testing.T) (client, client) {
+				return interfaceMockClient(t), failingMockClient(t)
+			},
+			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
+				goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_multiple_devices_partial_golden.yaml")
+				expectedMetrics, err := golden.ReadMetrics(goldenPath)
+				require.NoError(t, err)
+				return expectedMetrics
+			},
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("device https://bigip-b:443: some api error"), 0),
+		},
+		{
+			desc: "All Devices Failure",
+			setupMockClients: func(t *testing.T) (client, client) {
+				return failingMockClient(t), failingMockClient(t)
+			},
+			expectedMetricGen: func(*testing.T) pmetric.Metrics {
+				return pmetric.NewMetrics()
+			},
+			expectedErr: errors.New("device https://bigip-a:443: some api error; device https://bigip-b:443: some api error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Metrics.BigipNetInterfaceBitsReceived.Enabled = true
+			cfg.Metrics.BigipNetInterfaceBitsSent.Enabled = true
+			cfg.Metrics.BigipNetInterfaceDropsReceived.Enabled = true
+			cfg.Metrics.BigipNetInterfaceDropsSent.Enabled = true
+			cfg.LoginRetries = 0
+			scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopSettings(metadata.Type))
+			clientA, clientB := tc.setupMockClients(t)
+			scraper.devices = []bigipDevice{
+				{endpoint: "https://bigip-a:443", client: clientA},
+				{endpoint: "https://bigip-b:443", client: clientB},
+			}
+
+			actualMetrics, err := scraper.scrape(context.Background())
+
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			}
+
+			expectedMetrics := tc.expectedMetricGen(t)
 
 			err = pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
 				pmetrictest.IgnoreMetricDataPointsOrder(),
@@ -278,3 +1317,94 @@ func TestScraperScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestScraperScrapeFailoverStatusHAPair(t *testing.T) {
+	// failoverMockClient returns a mock client of a device only reporting its failover status
+	failoverMockClient := func(status *models.FailoverStatus) *mocks.MockClient {
+		mockClient := mocks.MockClient{}
+		mockClient.On("GetNewToken", mock.Anything).Return(nil)
+		mockClient.On("HasValidToken", mock.Anything).Return(false)
+		mockClient.On("GetVirtualServers", mock.Anything).Return(&models.VirtualServers{}, nil)
+		mockClient.On("GetPools", mock.Anything).Return(&models.Pools{}, nil)
+		mockClient.On("GetPoolMembers", mock.Anything, mock.Anything).Return(&models.PoolMembers{}, nil)
+		mockClient.On("GetNodes", mock.Anything).Return(&models.Nodes{}, nil)
+		mockClient.On("GetFailoverStatus", mock.Anything).Return(status, nil)
+		return &mockClient
+	}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.BigipCmFailoverActive.Enabled = true
+	cfg.Metrics.BigipCmSyncStatus.Enabled = true
+	scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopSettings(metadata.Type))
+	scraper.devices = []bigipDevice{
+		{
+			endpoint: "https://bigip-a:443",
+			client: failoverMockClient(&models.FailoverStatus{
+				Device:         "bigip-a.example.com",
+				FailoverStatus: "ACTIVE",
+				SyncStatus:     "In Sync",
+			}),
+		},
+		{
+			endpoint: "https://bigip-b:443",
+			client: failoverMockClient(&models.FailoverStatus{
+				Device:         "bigip-b.example.com",
+				FailoverStatus: "STANDBY",
+				SyncStatus:     "In Sync",
+			}),
+		},
+	}
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_failover_status_ha_pair_golden.yaml")
+	expectedMetrics, err := golden.ReadMetrics(goldenPath)
+	require.NoError(t, err)
+
+	err = pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreResourceMetricsOrder(), pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreTimestamp())
+	require.NoError(t, err)
+}
+
+func TestNormalizeStatusReason(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		reason   string
+		expected string
+	}{
+		{
+			desc:     "Empty reason",
+			reason:   "",
+			expected: "",
+		},
+		{
+			desc:     "Reason without timestamp",
+			reason:   "Pool member is available",
+			expected: "Pool member is available",
+		},
+		{
+			desc:     "Check timestamp is removed",
+			reason:   "/Common/http: No successful responses received before deadline. @2022/04/29 08:13:38. ",
+			expected: "/Common/http: No successful responses received before deadline.",
+		},
+		{
+			desc:     "Whitespace is collapsed",
+			reason:   "  /Common/tcp:\tConnection   refused \n",
+			expected: "/Common/tcp: Connection refused",
+		},
+		{
+			desc:     "Long reason is truncated",
+			reason:   strings.Repeat("é", maxStatusReasonLength+10),
+			expected: strings.Repeat("é", maxStatusReasonLength),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, normalizeStatusReason(tc.reason))
+		})
+	}
+}